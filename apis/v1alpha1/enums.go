@@ -3556,14 +3556,14 @@ const (
 	NetworkInterfacePermissionStateCode_revoking NetworkInterfacePermissionStateCode = "revoking"
 )
 
-type NetworkInterfaceStatus string
+type NetworkInterfaceStatus_SDK string
 
 const (
-	NetworkInterfaceStatus_associated NetworkInterfaceStatus = "associated"
-	NetworkInterfaceStatus_attaching  NetworkInterfaceStatus = "attaching"
-	NetworkInterfaceStatus_available  NetworkInterfaceStatus = "available"
-	NetworkInterfaceStatus_detaching  NetworkInterfaceStatus = "detaching"
-	NetworkInterfaceStatus_in_use     NetworkInterfaceStatus = "in-use"
+	NetworkInterfaceStatus_SDK_associated NetworkInterfaceStatus_SDK = "associated"
+	NetworkInterfaceStatus_SDK_attaching  NetworkInterfaceStatus_SDK = "attaching"
+	NetworkInterfaceStatus_SDK_available  NetworkInterfaceStatus_SDK = "available"
+	NetworkInterfaceStatus_SDK_detaching  NetworkInterfaceStatus_SDK = "detaching"
+	NetworkInterfaceStatus_SDK_in_use     NetworkInterfaceStatus_SDK = "in-use"
 )
 
 type NetworkInterfaceType string
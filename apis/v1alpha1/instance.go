@@ -134,7 +134,8 @@ type InstanceSpec struct {
 	//
 	// If you do not specify a key pair, you can't connect to the instance unless
 	// you choose an AMI that is configured to allow users another way to log in.
-	KeyName *string `json:"keyName,omitempty"`
+	KeyName *string                                  `json:"keyName,omitempty"`
+	KeyRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"keyRef,omitempty"`
 	// The launch template. Any additional parameters that you specify for the new
 	// instance overwrite the corresponding parameters included in the launch template.
 	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
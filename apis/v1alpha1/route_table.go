@@ -24,14 +24,24 @@ import (
 //
 // Describes a route table.
 type RouteTableSpec struct {
-	Routes []*CreateRouteInput `json:"routes,omitempty"`
+	BlackholeRoutePolicy      *string                                    `json:"blackholeRoutePolicy,omitempty"`
+	GatewayID                 *string                                    `json:"gatewayID,omitempty"`
+	GatewayRef                *ackv1alpha1.AWSResourceReferenceWrapper   `json:"gatewayRef,omitempty"`
+	IgnoredRouteDestinations  []*string                                  `json:"ignoredRouteDestinations,omitempty"`
+	Main                      *bool                                      `json:"main,omitempty"`
+	PropagatingVPNGateways    []*string                                  `json:"propagatingVPNGateways,omitempty"`
+	PropagatingVPNGatewayRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"propagatingVPNGatewayRefs,omitempty"`
+	Routes                    []*CreateRouteInput                        `json:"routes,omitempty"`
 	// The tags. The value parameter is required, but if you don't want the tag
 	// to have a value, specify the parameter with no value, and we set the value
 	// to an empty string.
-	Tags []*Tag `json:"tags,omitempty"`
+	Tags                 []*Tag  `json:"tags,omitempty"`
+	UnmanagedRoutePolicy *string `json:"unmanagedRoutePolicy,omitempty"`
 	// The ID of the VPC.
-	VPCID  *string                                  `json:"vpcID,omitempty"`
-	VPCRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"vpcRef,omitempty"`
+	VPCID         *string                                  `json:"vpcID,omitempty"`
+	VPCRef        *ackv1alpha1.AWSResourceReferenceWrapper `json:"vpcRef,omitempty"`
+	VPNGatewayID  *string                                  `json:"vpnGatewayID,omitempty"`
+	VPNGatewayRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"vpnGatewayRef,omitempty"`
 }
 
 // RouteTableStatus defines the observed state of RouteTable
@@ -50,18 +60,26 @@ type RouteTableStatus struct {
 	// The associations between the route table and your subnets or gateways.
 	// +kubebuilder:validation:Optional
 	Associations []*RouteTableAssociation `json:"associations,omitempty"`
+	// +kubebuilder:validation:Optional
+	OriginalMainRouteTableID *string `json:"originalMainRouteTableID,omitempty"`
 	// The ID of the Amazon Web Services account that owns the route table.
 	// +kubebuilder:validation:Optional
 	OwnerID *string `json:"ownerID,omitempty"`
 	// Any virtual private gateway (VGW) propagating routes.
 	// +kubebuilder:validation:Optional
 	PropagatingVGWs []*PropagatingVGW `json:"propagatingVGWs,omitempty"`
+	// +kubebuilder:validation:Optional
+	RouteFailures map[string]*string `json:"routeFailures,omitempty"`
+	// +kubebuilder:validation:Optional
+	RouteOwners map[string]*string `json:"routeOwners,omitempty"`
 	// The routes in the route table.
 	// +kubebuilder:validation:Optional
-	RouteStatuses []*Route `json:"routeStatuses,omitempty"`
+	RouteStatuses []*Route_SDK `json:"routeStatuses,omitempty"`
 	// The ID of the route table.
 	// +kubebuilder:validation:Optional
 	RouteTableID *string `json:"routeTableID,omitempty"`
+	// +kubebuilder:validation:Optional
+	UnmanagedRoutes []*Route_SDK `json:"unmanagedRoutes,omitempty"`
 }
 
 // RouteTable is the Schema for the RouteTables API
@@ -51,12 +51,22 @@ type SubnetSpec struct {
 	EnableResourceNameDNSAAAARecord *bool   `json:"enableResourceNameDNSAAAARecord,omitempty"`
 	EnableResourceNameDNSARecord    *bool   `json:"enableResourceNameDNSARecord,omitempty"`
 	HostnameType                    *string `json:"hostnameType,omitempty"`
+	// An IPv4 IPAM pool ID for the subnet.
+	IPv4IPAMPoolID  *string                                  `json:"ipv4IPAMPoolID,omitempty"`
+	IPv4IPAMPoolRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"ipv4IPAMPoolRef,omitempty"`
+	// An IPv4 netmask length for the subnet.
+	IPv4NetmaskLength *int64 `json:"ipv4NetmaskLength,omitempty"`
 	// The IPv6 network range for the subnet, in CIDR notation. This parameter is
 	// required for an IPv6 only subnet.
 	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
+	// An IPv6 IPAM pool ID for the subnet.
+	IPv6IPAMPoolID  *string                                  `json:"ipv6IPAMPoolID,omitempty"`
+	IPv6IPAMPoolRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"ipv6IPAMPoolRef,omitempty"`
 	// Indicates whether to create an IPv6 only subnet.
-	IPv6Native          *bool `json:"ipv6Native,omitempty"`
-	MapPublicIPOnLaunch *bool `json:"mapPublicIPOnLaunch,omitempty"`
+	IPv6Native *bool `json:"ipv6Native,omitempty"`
+	// An IPv6 netmask length for the subnet.
+	IPv6NetmaskLength   *int64 `json:"ipv6NetmaskLength,omitempty"`
+	MapPublicIPOnLaunch *bool  `json:"mapPublicIPOnLaunch,omitempty"`
 	// The Amazon Resource Name (ARN) of the Outpost. If you specify an Outpost
 	// ARN, you must also specify the Availability Zone of the Outpost subnet.
 	OutpostARN     *string                                    `json:"outpostARN,omitempty"`
@@ -84,6 +94,8 @@ type SubnetStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// +kubebuilder:validation:Optional
+	AllocatedCIDRBlock *string `json:"allocatedCIDRBlock,omitempty"`
 	// The number of unused private IPv4 addresses in the subnet. The IPv4 addresses
 	// for any stopped instances are considered unavailable.
 	// +kubebuilder:validation:Optional
@@ -265,6 +265,15 @@ type AssignedPrivateIPAddress struct {
 	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
 }
 
+type AssociateVPCCIDRBlockInput struct {
+	AmazonProvidedIPv6CIDRBlock     *bool   `json:"amazonProvidedIPv6CIDRBlock,omitempty"`
+	IPv6CIDRBlock                   *string `json:"ipv6CIDRBlock,omitempty"`
+	IPv6CIDRBlockNetworkBorderGroup *string `json:"ipv6CIDRBlockNetworkBorderGroup,omitempty"`
+	IPv6IPAMPoolID                  *string `json:"ipv6IPAMPoolID,omitempty"`
+	IPv6NetmaskLength               *int64  `json:"ipv6NetmaskLength,omitempty"`
+	IPv6Pool                        *string `json:"ipv6Pool,omitempty"`
+}
+
 // Information about the associated IAM roles.
 type AssociatedRole struct {
 	AssociatedRoleARN       *string `json:"associatedRoleARN,omitempty"`
@@ -800,7 +809,7 @@ type CapacityReservation_SDK struct {
 }
 
 // Describes a carrier gateway.
-type CarrierGateway struct {
+type CarrierGateway_SDK struct {
 	CarrierGatewayID *string `json:"carrierGatewayID,omitempty"`
 	OwnerID          *string `json:"ownerID,omitempty"`
 	Tags             []*Tag  `json:"tags,omitempty"`
@@ -1084,22 +1093,30 @@ type CreateFleetInstance struct {
 }
 
 type CreateRouteInput struct {
-	CarrierGatewayID            *string `json:"carrierGatewayID,omitempty"`
-	CoreNetworkARN              *string `json:"coreNetworkARN,omitempty"`
-	DestinationCIDRBlock        *string `json:"destinationCIDRBlock,omitempty"`
-	DestinationIPv6CIDRBlock    *string `json:"destinationIPv6CIDRBlock,omitempty"`
-	DestinationPrefixListID     *string `json:"destinationPrefixListID,omitempty"`
-	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
-	GatewayID                   *string `json:"gatewayID,omitempty"`
+	CarrierGatewayID *string `json:"carrierGatewayID,omitempty"`
+	// Reference field for CarrierGatewayID
+	CarrierGatewayRef           *ackv1alpha1.AWSResourceReferenceWrapper `json:"carrierGatewayRef,omitempty"`
+	CoreNetworkARN              *string                                  `json:"coreNetworkARN,omitempty"`
+	DestinationCIDRBlock        *string                                  `json:"destinationCIDRBlock,omitempty"`
+	DestinationIPv6CIDRBlock    *string                                  `json:"destinationIPv6CIDRBlock,omitempty"`
+	DestinationPrefixListID     *string                                  `json:"destinationPrefixListID,omitempty"`
+	EgressOnlyInternetGatewayID *string                                  `json:"egressOnlyInternetGatewayID,omitempty"`
+	// Reference field for EgressOnlyInternetGatewayID
+	EgressOnlyInternetGatewayRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"egressOnlyInternetGatewayRef,omitempty"`
+	GatewayID                    *string                                  `json:"gatewayID,omitempty"`
 	// Reference field for GatewayID
-	GatewayRef     *ackv1alpha1.AWSResourceReferenceWrapper `json:"gatewayRef,omitempty"`
-	InstanceID     *string                                  `json:"instanceID,omitempty"`
+	GatewayRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"gatewayRef,omitempty"`
+	InstanceID *string                                  `json:"instanceID,omitempty"`
+	// Reference field for InstanceID
+	InstanceRef    *ackv1alpha1.AWSResourceReferenceWrapper `json:"instanceRef,omitempty"`
 	LocalGatewayID *string                                  `json:"localGatewayID,omitempty"`
 	NATGatewayID   *string                                  `json:"natGatewayID,omitempty"`
 	// Reference field for NATGatewayID
 	NATGatewayRef      *ackv1alpha1.AWSResourceReferenceWrapper `json:"natGatewayRef,omitempty"`
 	NetworkInterfaceID *string                                  `json:"networkInterfaceID,omitempty"`
-	TransitGatewayID   *string                                  `json:"transitGatewayID,omitempty"`
+	// Reference field for NetworkInterfaceID
+	NetworkInterfaceRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"networkInterfaceRef,omitempty"`
+	TransitGatewayID    *string                                  `json:"transitGatewayID,omitempty"`
 	// Reference field for TransitGatewayID
 	TransitGatewayRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"transitGatewayRef,omitempty"`
 	VPCEndpointID     *string                                  `json:"vpcEndpointID,omitempty"`
@@ -1110,6 +1127,20 @@ type CreateRouteInput struct {
 	VPCPeeringConnectionRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"vpcPeeringConnectionRef,omitempty"`
 }
 
+// Describes whether dynamic routing is enabled or disabled for the transit gateway
+// peering request.
+type CreateTransitGatewayPeeringAttachmentRequestOptions struct {
+	DynamicRouting *string `json:"dynamicRouting,omitempty"`
+}
+
+type CreateTransitGatewayRouteInput struct {
+	Blackhole                  *bool   `json:"blackhole,omitempty"`
+	DestinationCIDRBlock       *string `json:"destinationCIDRBlock,omitempty"`
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
+	// Reference field for TransitGatewayAttachmentID
+	TransitGatewayAttachmentRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"transitGatewayAttachmentRef,omitempty"`
+}
+
 // Describes the options for a VPC attachment.
 type CreateTransitGatewayVPCAttachmentRequestOptions struct {
 	ApplianceModeSupport            *string `json:"applianceModeSupport,omitempty"`
@@ -1180,7 +1211,7 @@ type CreditSpecificationRequest struct {
 }
 
 // Describes a customer gateway.
-type CustomerGateway struct {
+type CustomerGateway_SDK struct {
 	BGPASN            *string `json:"bgpASN,omitempty"`
 	BGPASNExtended    *string `json:"bgpASNExtended,omitempty"`
 	CertificateARN    *string `json:"certificateARN,omitempty"`
@@ -2167,16 +2198,25 @@ type IKEVersionsRequestListValue struct {
 // Amazon Web Services Organization. For more information, see What is IPAM?
 // (https://docs.aws.amazon.com/vpc/latest/ipam/what-is-it-ipam.html) in the
 // Amazon VPC IPAM User Guide.
-type IPAM struct {
-	Description                       *string `json:"description,omitempty"`
-	EnablePrivateGua                  *bool   `json:"enablePrivateGua,omitempty"`
-	IPAMARN                           *string `json:"ipamARN,omitempty"`
-	IPAMRegion                        *string `json:"ipamRegion,omitempty"`
-	OwnerID                           *string `json:"ownerID,omitempty"`
-	ResourceDiscoveryAssociationCount *int64  `json:"resourceDiscoveryAssociationCount,omitempty"`
-	ScopeCount                        *int64  `json:"scopeCount,omitempty"`
-	StateMessage                      *string `json:"stateMessage,omitempty"`
-	Tags                              []*Tag  `json:"tags,omitempty"`
+type IPAM_SDK struct {
+	DefaultResourceDiscoveryAssociationID *string                `json:"defaultResourceDiscoveryAssociationID,omitempty"`
+	DefaultResourceDiscoveryID            *string                `json:"defaultResourceDiscoveryID,omitempty"`
+	Description                           *string                `json:"description,omitempty"`
+	EnablePrivateGua                      *bool                  `json:"enablePrivateGua,omitempty"`
+	IPAMARN                               *string                `json:"ipamARN,omitempty"`
+	IPAMID                                *string                `json:"ipamID,omitempty"`
+	IPAMRegion                            *string                `json:"ipamRegion,omitempty"`
+	MeteredAccount                        *string                `json:"meteredAccount,omitempty"`
+	OperatingRegions                      []*IPAMOperatingRegion `json:"operatingRegions,omitempty"`
+	OwnerID                               *string                `json:"ownerID,omitempty"`
+	PrivateDefaultScopeID                 *string                `json:"privateDefaultScopeID,omitempty"`
+	PublicDefaultScopeID                  *string                `json:"publicDefaultScopeID,omitempty"`
+	ResourceDiscoveryAssociationCount     *int64                 `json:"resourceDiscoveryAssociationCount,omitempty"`
+	ScopeCount                            *int64                 `json:"scopeCount,omitempty"`
+	State                                 *string                `json:"state,omitempty"`
+	StateMessage                          *string                `json:"stateMessage,omitempty"`
+	Tags                                  []*Tag                 `json:"tags,omitempty"`
+	Tier                                  *string                `json:"tier,omitempty"`
 }
 
 // The historical record of a CIDR within an IPAM scope. For more information,
@@ -2332,21 +2372,31 @@ type IPAMPolicyOrganizationTarget struct {
 // you to organize your IP addresses according to your routing and security
 // needs. For example, if you have separate routing and security needs for development
 // and production applications, you can create a pool for each.
-type IPAMPool struct {
-	AutoImport           *bool   `json:"autoImport,omitempty"`
-	Description          *string `json:"description,omitempty"`
-	IPAMARN              *string `json:"ipamARN,omitempty"`
-	IPAMPoolARN          *string `json:"ipamPoolARN,omitempty"`
-	IPAMPoolID           *string `json:"ipamPoolID,omitempty"`
-	IPAMRegion           *string `json:"ipamRegion,omitempty"`
-	IPAMScopeARN         *string `json:"ipamScopeARN,omitempty"`
-	Locale               *string `json:"locale,omitempty"`
-	OwnerID              *string `json:"ownerID,omitempty"`
-	PoolDepth            *int64  `json:"poolDepth,omitempty"`
-	PubliclyAdvertisable *bool   `json:"publiclyAdvertisable,omitempty"`
-	SourceIPAMPoolID     *string `json:"sourceIPAMPoolID,omitempty"`
-	StateMessage         *string `json:"stateMessage,omitempty"`
-	Tags                 []*Tag  `json:"tags,omitempty"`
+type IPAMPool_SDK struct {
+	AddressFamily                  *string                 `json:"addressFamily,omitempty"`
+	AllocationDefaultNetmaskLength *int64                  `json:"allocationDefaultNetmaskLength,omitempty"`
+	AllocationMaxNetmaskLength     *int64                  `json:"allocationMaxNetmaskLength,omitempty"`
+	AllocationMinNetmaskLength     *int64                  `json:"allocationMinNetmaskLength,omitempty"`
+	AllocationResourceTags         []*IPAMResourceTag      `json:"allocationResourceTags,omitempty"`
+	AutoImport                     *bool                   `json:"autoImport,omitempty"`
+	AWSService                     *string                 `json:"awsService,omitempty"`
+	Description                    *string                 `json:"description,omitempty"`
+	IPAMARN                        *string                 `json:"ipamARN,omitempty"`
+	IPAMPoolARN                    *string                 `json:"ipamPoolARN,omitempty"`
+	IPAMPoolID                     *string                 `json:"ipamPoolID,omitempty"`
+	IPAMRegion                     *string                 `json:"ipamRegion,omitempty"`
+	IPAMScopeARN                   *string                 `json:"ipamScopeARN,omitempty"`
+	IPAMScopeType                  *string                 `json:"ipamScopeType,omitempty"`
+	Locale                         *string                 `json:"locale,omitempty"`
+	OwnerID                        *string                 `json:"ownerID,omitempty"`
+	PoolDepth                      *int64                  `json:"poolDepth,omitempty"`
+	PublicIPSource                 *string                 `json:"publicIPSource,omitempty"`
+	PubliclyAdvertisable           *bool                   `json:"publiclyAdvertisable,omitempty"`
+	SourceIPAMPoolID               *string                 `json:"sourceIPAMPoolID,omitempty"`
+	SourceResource                 *IPAMPoolSourceResource `json:"sourceResource,omitempty"`
+	State                          *string                 `json:"state,omitempty"`
+	StateMessage                   *string                 `json:"stateMessage,omitempty"`
+	Tags                           []*Tag                  `json:"tags,omitempty"`
 }
 
 // In IPAM, an allocation is a CIDR assignment from an IPAM pool to another
@@ -2361,12 +2411,17 @@ type IPAMPoolAllocation struct {
 
 // A CIDR provisioned to an IPAM pool.
 type IPAMPoolCIDR struct {
-	CIDR          *string `json:"cidr,omitempty"`
-	NetmaskLength *int64  `json:"netmaskLength,omitempty"`
+	CIDR *string `json:"cidr,omitempty"`
+	// Details related to why an IPAM pool CIDR failed to be provisioned.
+	FailureReason  *IPAMPoolCIDRFailureReason `json:"failureReason,omitempty"`
+	IPAMPoolCIDRID *string                    `json:"ipamPoolCIDRID,omitempty"`
+	NetmaskLength  *int64                     `json:"netmaskLength,omitempty"`
+	State          *string                    `json:"state,omitempty"`
 }
 
 // Details related to why an IPAM pool CIDR failed to be provisioned.
 type IPAMPoolCIDRFailureReason struct {
+	Code    *string `json:"code,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
@@ -2375,6 +2430,7 @@ type IPAMPoolSourceResource struct {
 	ResourceID     *string `json:"resourceID,omitempty"`
 	ResourceOwner  *string `json:"resourceOwner,omitempty"`
 	ResourceRegion *string `json:"resourceRegion,omitempty"`
+	ResourceType   *string `json:"resourceType,omitempty"`
 }
 
 // The resource used to provision CIDRs to a resource planning pool.
@@ -2382,6 +2438,7 @@ type IPAMPoolSourceResourceRequest struct {
 	ResourceID     *string `json:"resourceID,omitempty"`
 	ResourceOwner  *string `json:"resourceOwner,omitempty"`
 	ResourceRegion *string `json:"resourceRegion,omitempty"`
+	ResourceType   *string `json:"resourceType,omitempty"`
 }
 
 // Describes an IPAM prefix list resolver.
@@ -2643,15 +2700,18 @@ type IPAMResourceTag struct {
 //
 // For more information, see How IPAM works (https://docs.aws.amazon.com/vpc/latest/ipam/how-it-works-ipam.html)
 // in the Amazon VPC IPAM User Guide.
-type IPAMScope struct {
-	Description  *string `json:"description,omitempty"`
-	IPAMARN      *string `json:"ipamARN,omitempty"`
-	IPAMRegion   *string `json:"ipamRegion,omitempty"`
-	IPAMScopeARN *string `json:"ipamScopeARN,omitempty"`
-	IsDefault    *bool   `json:"isDefault,omitempty"`
-	OwnerID      *string `json:"ownerID,omitempty"`
-	PoolCount    *int64  `json:"poolCount,omitempty"`
-	Tags         []*Tag  `json:"tags,omitempty"`
+type IPAMScope_SDK struct {
+	Description   *string `json:"description,omitempty"`
+	IPAMARN       *string `json:"ipamARN,omitempty"`
+	IPAMRegion    *string `json:"ipamRegion,omitempty"`
+	IPAMScopeARN  *string `json:"ipamScopeARN,omitempty"`
+	IPAMScopeID   *string `json:"ipamScopeID,omitempty"`
+	IPAMScopeType *string `json:"ipamScopeType,omitempty"`
+	IsDefault     *bool   `json:"isDefault,omitempty"`
+	OwnerID       *string `json:"ownerID,omitempty"`
+	PoolCount     *int64  `json:"poolCount,omitempty"`
+	State         *string `json:"state,omitempty"`
+	Tags          []*Tag  `json:"tags,omitempty"`
 }
 
 // The configuration that links an Amazon VPC IPAM scope to an external authority
@@ -4082,15 +4142,17 @@ type LaunchTemplatePlacement struct {
 
 // Describes the placement of an instance.
 type LaunchTemplatePlacementRequest struct {
-	Affinity             *string `json:"affinity,omitempty"`
-	AvailabilityZone     *string `json:"availabilityZone,omitempty"`
-	GroupID              *string `json:"groupID,omitempty"`
-	GroupName            *string `json:"groupName,omitempty"`
-	HostID               *string `json:"hostID,omitempty"`
-	HostResourceGroupARN *string `json:"hostResourceGroupARN,omitempty"`
-	PartitionNumber      *int64  `json:"partitionNumber,omitempty"`
-	SpreadDomain         *string `json:"spreadDomain,omitempty"`
-	Tenancy              *string `json:"tenancy,omitempty"`
+	Affinity         *string `json:"affinity,omitempty"`
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	GroupID          *string `json:"groupID,omitempty"`
+	GroupName        *string `json:"groupName,omitempty"`
+	// Reference field for GroupName
+	GroupRef             *ackv1alpha1.AWSResourceReferenceWrapper `json:"groupRef,omitempty"`
+	HostID               *string                                  `json:"hostID,omitempty"`
+	HostResourceGroupARN *string                                  `json:"hostResourceGroupARN,omitempty"`
+	PartitionNumber      *int64                                   `json:"partitionNumber,omitempty"`
+	SpreadDomain         *string                                  `json:"spreadDomain,omitempty"`
+	Tenancy              *string                                  `json:"tenancy,omitempty"`
 }
 
 // Describes the options for instance hostnames.
@@ -4611,31 +4673,51 @@ type NetworkInsightsPath struct {
 }
 
 // Describes a network interface.
-type NetworkInterface struct {
-	AvailabilityZone   *string            `json:"availabilityZone,omitempty"`
-	AvailabilityZoneID *string            `json:"availabilityZoneID,omitempty"`
-	DenyAllIgwTraffic  *bool              `json:"denyAllIgwTraffic,omitempty"`
-	Description        *string            `json:"description,omitempty"`
-	Groups             []*GroupIdentifier `json:"groups,omitempty"`
-	IPv6Address        *string            `json:"ipv6Address,omitempty"`
-	IPv6Native         *bool              `json:"ipv6Native,omitempty"`
-	MacAddress         *string            `json:"macAddress,omitempty"`
-	NetworkInterfaceID *string            `json:"networkInterfaceID,omitempty"`
+type NetworkInterface_SDK struct {
+	AssociatedSubnets []*string `json:"associatedSubnets,omitempty"`
+	// Describes association information for an Elastic IP address (IPv4 only),
+	// or a Carrier IP address (for a network interface which resides in a subnet
+	// in a Wavelength Zone).
+	Association *NetworkInterfaceAssociation `json:"association,omitempty"`
+	// Describes a network interface attachment.
+	Attachment         *NetworkInterfaceAttachment `json:"attachment,omitempty"`
+	AvailabilityZone   *string                     `json:"availabilityZone,omitempty"`
+	AvailabilityZoneID *string                     `json:"availabilityZoneID,omitempty"`
+	// A security group connection tracking configuration that enables you to set
+	// the idle timeout for connection tracking on an Elastic network interface.
+	// For more information, see Connection tracking timeouts (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/security-group-connection-tracking.html#connection-tracking-timeouts)
+	// in the Amazon EC2 User Guide.
+	ConnectionTrackingConfiguration *ConnectionTrackingConfiguration `json:"connectionTrackingConfiguration,omitempty"`
+	DenyAllIgwTraffic               *bool                            `json:"denyAllIgwTraffic,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	Groups                          []*GroupIdentifier               `json:"groups,omitempty"`
+	InterfaceType                   *string                          `json:"interfaceType,omitempty"`
+	IPv4Prefixes                    []*IPv4PrefixSpecification       `json:"ipv4Prefixes,omitempty"`
+	IPv6Address                     *string                          `json:"ipv6Address,omitempty"`
+	IPv6Addresses                   []*NetworkInterfaceIPv6Address   `json:"ipv6Addresses,omitempty"`
+	IPv6Native                      *bool                            `json:"ipv6Native,omitempty"`
+	IPv6Prefixes                    []*IPv6PrefixSpecification       `json:"ipv6Prefixes,omitempty"`
+	MacAddress                      *string                          `json:"macAddress,omitempty"`
+	NetworkInterfaceID              *string                          `json:"networkInterfaceID,omitempty"`
 	// Describes whether the resource is managed by a service provider and, if so,
 	// describes the service provider that manages it.
-	Operator         *OperatorResponse `json:"operator,omitempty"`
-	OutpostARN       *string           `json:"outpostARN,omitempty"`
-	OwnerID          *string           `json:"ownerID,omitempty"`
-	PrivateDNSName   *string           `json:"privateDNSName,omitempty"`
-	PrivateIPAddress *string           `json:"privateIPAddress,omitempty"`
-	PublicDNSName    *string           `json:"publicDNSName,omitempty"`
-	RequesterID      *string           `json:"requesterID,omitempty"`
-	RequesterManaged *bool             `json:"requesterManaged,omitempty"`
-	SourceDestCheck  *bool             `json:"sourceDestCheck,omitempty"`
-	Status           *string           `json:"status,omitempty"`
-	SubnetID         *string           `json:"subnetID,omitempty"`
-	TagSet           []*Tag            `json:"tagSet,omitempty"`
-	VPCID            *string           `json:"vpcID,omitempty"`
+	Operator           *OperatorResponse                   `json:"operator,omitempty"`
+	OutpostARN         *string                             `json:"outpostARN,omitempty"`
+	OwnerID            *string                             `json:"ownerID,omitempty"`
+	PrivateDNSName     *string                             `json:"privateDNSName,omitempty"`
+	PrivateIPAddress   *string                             `json:"privateIPAddress,omitempty"`
+	PrivateIPAddresses []*NetworkInterfacePrivateIPAddress `json:"privateIPAddresses,omitempty"`
+	PublicDNSName      *string                             `json:"publicDNSName,omitempty"`
+	// Public hostname type options. For more information, see EC2 instance hostnames,
+	// DNS names, and domains (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-naming.html)
+	// in the Amazon EC2 User Guide.
+	PublicIPDNSNameOptions *PublicIPDNSNameOptions `json:"publicIPDNSNameOptions,omitempty"`
+	RequesterID            *string                 `json:"requesterID,omitempty"`
+	RequesterManaged       *bool                   `json:"requesterManaged,omitempty"`
+	SourceDestCheck        *bool                   `json:"sourceDestCheck,omitempty"`
+	Status                 *string                 `json:"status,omitempty"`
+	SubnetID               *string                 `json:"subnetID,omitempty"`
+	VPCID                  *string                 `json:"vpcID,omitempty"`
 }
 
 // Describes association information for an Elastic IP address (IPv4 only),
@@ -4905,18 +4987,20 @@ type Phase2IntegrityAlgorithmsRequestListValue struct {
 
 // Describes the placement of an instance.
 type Placement struct {
-	Affinity             *string `json:"affinity,omitempty"`
-	AvailabilityZone     *string `json:"availabilityZone,omitempty"`
-	GroupName            *string `json:"groupName,omitempty"`
-	HostID               *string `json:"hostID,omitempty"`
-	HostResourceGroupARN *string `json:"hostResourceGroupARN,omitempty"`
-	PartitionNumber      *int64  `json:"partitionNumber,omitempty"`
-	SpreadDomain         *string `json:"spreadDomain,omitempty"`
-	Tenancy              *string `json:"tenancy,omitempty"`
+	Affinity         *string `json:"affinity,omitempty"`
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	GroupName        *string `json:"groupName,omitempty"`
+	// Reference field for GroupName
+	GroupRef             *ackv1alpha1.AWSResourceReferenceWrapper `json:"groupRef,omitempty"`
+	HostID               *string                                  `json:"hostID,omitempty"`
+	HostResourceGroupARN *string                                  `json:"hostResourceGroupARN,omitempty"`
+	PartitionNumber      *int64                                   `json:"partitionNumber,omitempty"`
+	SpreadDomain         *string                                  `json:"spreadDomain,omitempty"`
+	Tenancy              *string                                  `json:"tenancy,omitempty"`
 }
 
 // Describes a placement group.
-type PlacementGroup struct {
+type PlacementGroup_SDK struct {
 	GroupARN      *string `json:"groupARN,omitempty"`
 	GroupID       *string `json:"groupID,omitempty"`
 	GroupName     *string `json:"groupName,omitempty"`
@@ -5266,10 +5350,12 @@ type RequestLaunchTemplateData struct {
 	// for EC2 Fleet or Spot Fleet (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-fleet-attribute-based-instance-type-selection.html)
 	// and Spot placement score (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-placement-score.html)
 	// in the Amazon EC2 User Guide.
-	InstanceRequirements  *InstanceRequirementsRequest                 `json:"instanceRequirements,omitempty"`
-	InstanceType          *string                                      `json:"instanceType,omitempty"`
-	KernelID              *string                                      `json:"kernelID,omitempty"`
-	KeyName               *string                                      `json:"keyName,omitempty"`
+	InstanceRequirements *InstanceRequirementsRequest `json:"instanceRequirements,omitempty"`
+	InstanceType         *string                      `json:"instanceType,omitempty"`
+	KernelID             *string                      `json:"kernelID,omitempty"`
+	KeyName              *string                      `json:"keyName,omitempty"`
+	// Reference field for KeyName
+	KeyRef                *ackv1alpha1.AWSResourceReferenceWrapper     `json:"keyRef,omitempty"`
 	LicenseSpecifications []*LaunchTemplateLicenseConfigurationRequest `json:"licenseSpecifications,omitempty"`
 	// The maintenance options of your instance.
 	MaintenanceOptions *LaunchTemplateInstanceMaintenanceOptionsRequest `json:"maintenanceOptions,omitempty"`
@@ -5532,7 +5618,7 @@ type RevokedSecurityGroupRule struct {
 }
 
 // Describes a route in a route table.
-type Route struct {
+type Route_SDK struct {
 	CarrierGatewayID            *string `json:"carrierGatewayID,omitempty"`
 	CoreNetworkARN              *string `json:"coreNetworkARN,omitempty"`
 	DestinationCIDRBlock        *string `json:"destinationCIDRBlock,omitempty"`
@@ -5681,7 +5767,7 @@ type RouteTable_SDK struct {
 	OwnerID         *string                  `json:"ownerID,omitempty"`
 	PropagatingVGWs []*PropagatingVGW        `json:"propagatingVGWs,omitempty"`
 	RouteTableID    *string                  `json:"routeTableID,omitempty"`
-	Routes          []*Route                 `json:"routes,omitempty"`
+	Routes          []*Route_SDK             `json:"routes,omitempty"`
 	Tags            []*Tag                   `json:"tags,omitempty"`
 	VPCID           *string                  `json:"vpcID,omitempty"`
 }
@@ -6836,12 +6922,25 @@ type TransitGatewayOptions struct {
 }
 
 // Describes the transit gateway peering attachment.
-type TransitGatewayPeeringAttachment struct {
-	AccepterTransitGatewayAttachmentID *string      `json:"accepterTransitGatewayAttachmentID,omitempty"`
-	CreationTime                       *metav1.Time `json:"creationTime,omitempty"`
-	State                              *string      `json:"state,omitempty"`
-	Tags                               []*Tag       `json:"tags,omitempty"`
-	TransitGatewayAttachmentID         *string      `json:"transitGatewayAttachmentID,omitempty"`
+type TransitGatewayPeeringAttachment_SDK struct {
+	// Information about the transit gateway in the peering attachment.
+	AccepterTgwInfo                    *PeeringTgwInfo `json:"accepterTgwInfo,omitempty"`
+	AccepterTransitGatewayAttachmentID *string         `json:"accepterTransitGatewayAttachmentID,omitempty"`
+	CreationTime                       *metav1.Time    `json:"creationTime,omitempty"`
+	// Describes dynamic routing for the transit gateway peering attachment.
+	Options *TransitGatewayPeeringAttachmentOptions `json:"options,omitempty"`
+	// Information about the transit gateway in the peering attachment.
+	RequesterTgwInfo *PeeringTgwInfo `json:"requesterTgwInfo,omitempty"`
+	State            *string         `json:"state,omitempty"`
+	// The status of the transit gateway peering attachment.
+	Status                     *PeeringAttachmentStatus `json:"status,omitempty"`
+	Tags                       []*Tag                   `json:"tags,omitempty"`
+	TransitGatewayAttachmentID *string                  `json:"transitGatewayAttachmentID,omitempty"`
+}
+
+// Describes dynamic routing for the transit gateway peering attachment.
+type TransitGatewayPeeringAttachmentOptions struct {
+	DynamicRouting *string `json:"dynamicRouting,omitempty"`
 }
 
 // Describes a rule associated with a transit gateway policy.
@@ -6911,18 +7010,23 @@ type TransitGatewayRequestOptions struct {
 
 // Describes a route for a transit gateway route table.
 type TransitGatewayRoute struct {
-	DestinationCIDRBlock *string `json:"destinationCIDRBlock,omitempty"`
-	PrefixListID         *string `json:"prefixListID,omitempty"`
+	DestinationCIDRBlock                   *string                          `json:"destinationCIDRBlock,omitempty"`
+	PrefixListID                           *string                          `json:"prefixListID,omitempty"`
+	State                                  *string                          `json:"state,omitempty"`
+	TransitGatewayAttachments              []*TransitGatewayRouteAttachment `json:"transitGatewayAttachments,omitempty"`
+	TransitGatewayRouteTableAnnouncementID *string                          `json:"transitGatewayRouteTableAnnouncementID,omitempty"`
+	Type                                   *string                          `json:"type_,omitempty"`
 }
 
 // Describes a route attachment.
 type TransitGatewayRouteAttachment struct {
 	ResourceID                 *string `json:"resourceID,omitempty"`
+	ResourceType               *string `json:"resourceType,omitempty"`
 	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
 }
 
 // Describes a transit gateway route table.
-type TransitGatewayRouteTable struct {
+type TransitGatewayRouteTable_SDK struct {
 	CreationTime                 *metav1.Time `json:"creationTime,omitempty"`
 	DefaultAssociationRouteTable *bool        `json:"defaultAssociationRouteTable,omitempty"`
 	DefaultPropagationRouteTable *bool        `json:"defaultPropagationRouteTable,omitempty"`
@@ -7103,6 +7207,7 @@ type VGWTelemetry struct {
 	CertificateARN     *string      `json:"certificateARN,omitempty"`
 	LastStatusChange   *metav1.Time `json:"lastStatusChange,omitempty"`
 	OutsideIPAddress   *string      `json:"outsideIPAddress,omitempty"`
+	Status             *string      `json:"status,omitempty"`
 	StatusMessage      *string      `json:"statusMessage,omitempty"`
 }
 
@@ -7325,20 +7430,6 @@ type VPNConcentrator struct {
 	VPNConcentratorID          *string `json:"vpnConcentratorID,omitempty"`
 }
 
-// Describes a VPN connection.
-type VPNConnection struct {
-	Category                 *string `json:"category,omitempty"`
-	CoreNetworkARN           *string `json:"coreNetworkARN,omitempty"`
-	CoreNetworkAttachmentARN *string `json:"coreNetworkAttachmentARN,omitempty"`
-	CustomerGatewayID        *string `json:"customerGatewayID,omitempty"`
-	PreSharedKeyARN          *string `json:"preSharedKeyARN,omitempty"`
-	Tags                     []*Tag  `json:"tags,omitempty"`
-	TransitGatewayID         *string `json:"transitGatewayID,omitempty"`
-	VPNConcentratorID        *string `json:"vpnConcentratorID,omitempty"`
-	VPNConnectionID          *string `json:"vpnConnectionID,omitempty"`
-	VPNGatewayID             *string `json:"vpnGatewayID,omitempty"`
-}
-
 // List of customer gateway devices that have a sample configuration file available
 // for use. You can also see the list of device types with sample configuration
 // files available under Your customer gateway device (https://docs.aws.amazon.com/vpn/latest/s2svpn/your-cgw.html)
@@ -7352,39 +7443,71 @@ type VPNConnectionDeviceType struct {
 
 // Describes VPN connection options.
 type VPNConnectionOptions struct {
-	EnableAcceleration                  *bool   `json:"enableAcceleration,omitempty"`
-	LocalIPv4NetworkCIDR                *string `json:"localIPv4NetworkCIDR,omitempty"`
-	LocalIPv6NetworkCIDR                *string `json:"localIPv6NetworkCIDR,omitempty"`
-	OutsideIPAddressType                *string `json:"outsideIPAddressType,omitempty"`
-	RemoteIPv4NetworkCIDR               *string `json:"remoteIPv4NetworkCIDR,omitempty"`
-	RemoteIPv6NetworkCIDR               *string `json:"remoteIPv6NetworkCIDR,omitempty"`
-	StaticRoutesOnly                    *bool   `json:"staticRoutesOnly,omitempty"`
-	TransportTransitGatewayAttachmentID *string `json:"transportTransitGatewayAttachmentID,omitempty"`
+	EnableAcceleration                  *bool           `json:"enableAcceleration,omitempty"`
+	LocalIPv4NetworkCIDR                *string         `json:"localIPv4NetworkCIDR,omitempty"`
+	LocalIPv6NetworkCIDR                *string         `json:"localIPv6NetworkCIDR,omitempty"`
+	OutsideIPAddressType                *string         `json:"outsideIPAddressType,omitempty"`
+	RemoteIPv4NetworkCIDR               *string         `json:"remoteIPv4NetworkCIDR,omitempty"`
+	RemoteIPv6NetworkCIDR               *string         `json:"remoteIPv6NetworkCIDR,omitempty"`
+	StaticRoutesOnly                    *bool           `json:"staticRoutesOnly,omitempty"`
+	TransportTransitGatewayAttachmentID *string         `json:"transportTransitGatewayAttachmentID,omitempty"`
+	TunnelBandwidth                     *string         `json:"tunnelBandwidth,omitempty"`
+	TunnelInsideIPVersion               *string         `json:"tunnelInsideIPVersion,omitempty"`
+	TunnelOptions                       []*TunnelOption `json:"tunnelOptions,omitempty"`
 }
 
 // Describes VPN connection options.
 type VPNConnectionOptionsSpecification struct {
-	EnableAcceleration                  *bool   `json:"enableAcceleration,omitempty"`
-	LocalIPv4NetworkCIDR                *string `json:"localIPv4NetworkCIDR,omitempty"`
-	LocalIPv6NetworkCIDR                *string `json:"localIPv6NetworkCIDR,omitempty"`
-	OutsideIPAddressType                *string `json:"outsideIPAddressType,omitempty"`
-	RemoteIPv4NetworkCIDR               *string `json:"remoteIPv4NetworkCIDR,omitempty"`
-	RemoteIPv6NetworkCIDR               *string `json:"remoteIPv6NetworkCIDR,omitempty"`
-	StaticRoutesOnly                    *bool   `json:"staticRoutesOnly,omitempty"`
-	TransportTransitGatewayAttachmentID *string `json:"transportTransitGatewayAttachmentID,omitempty"`
+	EnableAcceleration                  *bool                            `json:"enableAcceleration,omitempty"`
+	LocalIPv4NetworkCIDR                *string                          `json:"localIPv4NetworkCIDR,omitempty"`
+	LocalIPv6NetworkCIDR                *string                          `json:"localIPv6NetworkCIDR,omitempty"`
+	OutsideIPAddressType                *string                          `json:"outsideIPAddressType,omitempty"`
+	RemoteIPv4NetworkCIDR               *string                          `json:"remoteIPv4NetworkCIDR,omitempty"`
+	RemoteIPv6NetworkCIDR               *string                          `json:"remoteIPv6NetworkCIDR,omitempty"`
+	StaticRoutesOnly                    *bool                            `json:"staticRoutesOnly,omitempty"`
+	TransportTransitGatewayAttachmentID *string                          `json:"transportTransitGatewayAttachmentID,omitempty"`
+	TunnelBandwidth                     *string                          `json:"tunnelBandwidth,omitempty"`
+	TunnelInsideIPVersion               *string                          `json:"tunnelInsideIPVersion,omitempty"`
+	TunnelOptions                       []*VPNTunnelOptionsSpecification `json:"tunnelOptions,omitempty"`
+}
+
+// Describes a VPN connection.
+type VPNConnection_SDK struct {
+	Category                 *string `json:"category,omitempty"`
+	CoreNetworkARN           *string `json:"coreNetworkARN,omitempty"`
+	CoreNetworkAttachmentARN *string `json:"coreNetworkAttachmentARN,omitempty"`
+	CustomerGatewayID        *string `json:"customerGatewayID,omitempty"`
+	GatewayAssociationState  *string `json:"gatewayAssociationState,omitempty"`
+	// Describes VPN connection options.
+	Options           *VPNConnectionOptions `json:"options,omitempty"`
+	PreSharedKeyARN   *string               `json:"preSharedKeyARN,omitempty"`
+	Routes            []*VPNStaticRoute     `json:"routes,omitempty"`
+	State             *string               `json:"state,omitempty"`
+	Tags              []*Tag                `json:"tags,omitempty"`
+	TransitGatewayID  *string               `json:"transitGatewayID,omitempty"`
+	Type              *string               `json:"type_,omitempty"`
+	VGWTelemetry      []*VGWTelemetry       `json:"vgwTelemetry,omitempty"`
+	VPNConcentratorID *string               `json:"vpnConcentratorID,omitempty"`
+	VPNConnectionID   *string               `json:"vpnConnectionID,omitempty"`
+	VPNGatewayID      *string               `json:"vpnGatewayID,omitempty"`
 }
 
 // Describes a virtual private gateway.
-type VPNGateway struct {
-	AmazonSideASN    *int64  `json:"amazonSideASN,omitempty"`
-	AvailabilityZone *string `json:"availabilityZone,omitempty"`
-	Tags             []*Tag  `json:"tags,omitempty"`
-	VPNGatewayID     *string `json:"vpnGatewayID,omitempty"`
+type VPNGateway_SDK struct {
+	AmazonSideASN    *int64           `json:"amazonSideASN,omitempty"`
+	AvailabilityZone *string          `json:"availabilityZone,omitempty"`
+	State            *string          `json:"state,omitempty"`
+	Tags             []*Tag           `json:"tags,omitempty"`
+	Type             *string          `json:"type_,omitempty"`
+	VPCAttachments   []*VPCAttachment `json:"vpcAttachments,omitempty"`
+	VPNGatewayID     *string          `json:"vpnGatewayID,omitempty"`
 }
 
 // Describes a static route for a VPN connection.
 type VPNStaticRoute struct {
 	DestinationCIDRBlock *string `json:"destinationCIDRBlock,omitempty"`
+	Source               *string `json:"source,omitempty"`
+	State                *string `json:"state,omitempty"`
 }
 
 // The tunnel options for a single VPN tunnel.
@@ -7613,6 +7736,44 @@ type VerifiedAccessTrustProviderCondensed struct {
 	VerifiedAccessTrustProviderID *string `json:"verifiedAccessTrustProviderID,omitempty"`
 }
 
+// Describes a volume.
+type Volume_SDK struct {
+	Attachments        []*VolumeAttachment `json:"attachments,omitempty"`
+	AvailabilityZone   *string             `json:"availabilityZone,omitempty"`
+	AvailabilityZoneID *string             `json:"availabilityZoneID,omitempty"`
+	CreateTime         *metav1.Time        `json:"createTime,omitempty"`
+	Encrypted          *bool               `json:"encrypted,omitempty"`
+	FastRestored       *bool               `json:"fastRestored,omitempty"`
+	IOPS               *int64              `json:"iops,omitempty"`
+	KMSKeyID           *string             `json:"kmsKeyID,omitempty"`
+	MultiAttachEnabled *bool               `json:"multiAttachEnabled,omitempty"`
+	// Describes whether the resource is managed by a service provider and, if so,
+	// describes the service provider that manages it.
+	Operator       *OperatorResponse `json:"operator,omitempty"`
+	OutpostARN     *string           `json:"outpostARN,omitempty"`
+	Size           *int64            `json:"size,omitempty"`
+	SnapshotID     *string           `json:"snapshotID,omitempty"`
+	SourceVolumeID *string           `json:"sourceVolumeID,omitempty"`
+	SSEType        *string           `json:"sseType,omitempty"`
+	State          *string           `json:"state,omitempty"`
+	Tags           []*Tag            `json:"tags,omitempty"`
+	Throughput     *int64            `json:"throughput,omitempty"`
+	VolumeID       *string           `json:"volumeID,omitempty"`
+	VolumeType     *string           `json:"volumeType,omitempty"`
+}
+
+// Describes volume attachment details.
+type VolumeAttachment struct {
+	AssociatedResource    *string      `json:"associatedResource,omitempty"`
+	AttachTime            *metav1.Time `json:"attachTime,omitempty"`
+	DeleteOnTermination   *bool        `json:"deleteOnTermination,omitempty"`
+	Device                *string      `json:"device,omitempty"`
+	InstanceID            *string      `json:"instanceID,omitempty"`
+	InstanceOwningService *string      `json:"instanceOwningService,omitempty"`
+	State                 *string      `json:"state,omitempty"`
+	VolumeID              *string      `json:"volumeID,omitempty"`
+}
+
 // Describes an EBS volume.
 type VolumeDetail struct {
 	Size *int64 `json:"size,omitempty"`
@@ -7621,6 +7782,7 @@ type VolumeDetail struct {
 // Describes the modification status of an EBS volume.
 type VolumeModification struct {
 	EndTime                    *metav1.Time `json:"endTime,omitempty"`
+	ModificationState          *string      `json:"modificationState,omitempty"`
 	OriginalIOPS               *int64       `json:"originalIOPS,omitempty"`
 	OriginalMultiAttachEnabled *bool        `json:"originalMultiAttachEnabled,omitempty"`
 	OriginalSize               *int64       `json:"originalSize,omitempty"`
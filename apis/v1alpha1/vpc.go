@@ -28,9 +28,8 @@ type VPCSpec struct {
 	// Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length for
 	// the VPC. You cannot specify the range of IP addresses, or the size of the
 	// CIDR block.
-	AmazonProvidedIPv6CIDRBlock *bool `json:"amazonProvidedIPv6CIDRBlock,omitempty"`
-	// +kubebuilder:validation:Required
-	CIDRBlocks                        []*string `json:"cidrBlocks"`
+	AmazonProvidedIPv6CIDRBlock       *bool     `json:"amazonProvidedIPv6CIDRBlock,omitempty"`
+	CIDRBlocks                        []*string `json:"cidrBlocks,omitempty"`
 	DisallowSecurityGroupDefaultRules *bool     `json:"disallowSecurityGroupDefaultRules,omitempty"`
 	// The attribute value. The valid values are true or false.
 	EnableDNSHostnames *bool `json:"enableDNSHostnames,omitempty"`
@@ -52,7 +51,8 @@ type VPCSpec struct {
 	// The ID of an IPv4 IPAM pool you want to use for allocating this VPC's CIDR.
 	// For more information, see What is IPAM? (https://docs.aws.amazon.com/vpc/latest/ipam/what-is-it-ipam.html)
 	// in the Amazon VPC IPAM User Guide.
-	IPv4IPAMPoolID *string `json:"ipv4IPAMPoolID,omitempty"`
+	IPv4IPAMPoolID  *string                                  `json:"ipv4IPAMPoolID,omitempty"`
+	IPv4IPAMPoolRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"ipv4IPAMPoolRef,omitempty"`
 	// The netmask length of the IPv4 CIDR you want to allocate to this VPC from
 	// an Amazon VPC IP Address Manager (IPAM) pool. For more information about
 	// IPAM, see What is IPAM? (https://docs.aws.amazon.com/vpc/latest/ipam/what-is-it-ipam.html)
@@ -67,7 +67,8 @@ type VPCSpec struct {
 	// this parameter to limit the address to this location.
 	//
 	// You must set AmazonProvidedIpv6CidrBlock to true to use this parameter.
-	IPv6CIDRBlockNetworkBorderGroup *string `json:"ipv6CIDRBlockNetworkBorderGroup,omitempty"`
+	IPv6CIDRBlockNetworkBorderGroup *string                       `json:"ipv6CIDRBlockNetworkBorderGroup,omitempty"`
+	IPv6CIDRBlocks                  []*AssociateVPCCIDRBlockInput `json:"ipv6CIDRBlocks,omitempty"`
 	// The ID of an IPv6 IPAM pool which will be used to allocate this VPC an IPv6
 	// CIDR. IPAM is a VPC feature that you can use to automate your IP address
 	// management workflows including assigning, tracking, troubleshooting, and
@@ -75,7 +76,8 @@ type VPCSpec struct {
 	// your Amazon Web Services Organization. For more information, see What is
 	// IPAM? (https://docs.aws.amazon.com/vpc/latest/ipam/what-is-it-ipam.html)
 	// in the Amazon VPC IPAM User Guide.
-	IPv6IPAMPoolID *string `json:"ipv6IPAMPoolID,omitempty"`
+	IPv6IPAMPoolID  *string                                  `json:"ipv6IPAMPoolID,omitempty"`
+	IPv6IPAMPoolRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"ipv6IPAMPoolRef,omitempty"`
 	// The netmask length of the IPv6 CIDR you want to allocate to this VPC from
 	// an Amazon VPC IP Address Manager (IPAM) pool. For more information about
 	// IPAM, see What is IPAM? (https://docs.aws.amazon.com/vpc/latest/ipam/what-is-it-ipam.html)
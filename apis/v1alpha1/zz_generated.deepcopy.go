@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -949,6 +950,51 @@ func (in *AssignedPrivateIPAddress) DeepCopy() *AssignedPrivateIPAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssociateVPCCIDRBlockInput) DeepCopyInto(out *AssociateVPCCIDRBlockInput) {
+	*out = *in
+	if in.AmazonProvidedIPv6CIDRBlock != nil {
+		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlockNetworkBorderGroup != nil {
+		in, out := &in.IPv6CIDRBlockNetworkBorderGroup, &out.IPv6CIDRBlockNetworkBorderGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6IPAMPoolID != nil {
+		in, out := &in.IPv6IPAMPoolID, &out.IPv6IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6NetmaskLength != nil {
+		in, out := &in.IPv6NetmaskLength, &out.IPv6NetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssociateVPCCIDRBlockInput.
+func (in *AssociateVPCCIDRBlockInput) DeepCopy() *AssociateVPCCIDRBlockInput {
+	if in == nil {
+		return nil
+	}
+	out := new(AssociateVPCCIDRBlockInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssociatedRole) DeepCopyInto(out *AssociatedRole) {
 	*out = *in
@@ -3142,6 +3188,147 @@ func (in *CapacityReservation_SDK) DeepCopy() *CapacityReservation_SDK {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CarrierGateway) DeepCopyInto(out *CarrierGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGateway.
+func (in *CarrierGateway) DeepCopy() *CarrierGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(CarrierGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CarrierGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarrierGatewayList) DeepCopyInto(out *CarrierGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CarrierGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGatewayList.
+func (in *CarrierGatewayList) DeepCopy() *CarrierGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(CarrierGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CarrierGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarrierGatewaySpec) DeepCopyInto(out *CarrierGatewaySpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGatewaySpec.
+func (in *CarrierGatewaySpec) DeepCopy() *CarrierGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarrierGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarrierGatewayStatus) DeepCopyInto(out *CarrierGatewayStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CarrierGatewayID != nil {
+		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGatewayStatus.
+func (in *CarrierGatewayStatus) DeepCopy() *CarrierGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CarrierGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarrierGateway_SDK) DeepCopyInto(out *CarrierGateway_SDK) {
 	*out = *in
 	if in.CarrierGatewayID != nil {
 		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
@@ -3171,12 +3358,12 @@ func (in *CarrierGateway) DeepCopyInto(out *CarrierGateway) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGateway.
-func (in *CarrierGateway) DeepCopy() *CarrierGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarrierGateway_SDK.
+func (in *CarrierGateway_SDK) DeepCopy() *CarrierGateway_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(CarrierGateway)
+	out := new(CarrierGateway_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4326,6 +4513,11 @@ func (in *CreateRouteInput) DeepCopyInto(out *CreateRouteInput) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CarrierGatewayRef != nil {
+		in, out := &in.CarrierGatewayRef, &out.CarrierGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CoreNetworkARN != nil {
 		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
 		*out = new(string)
@@ -4351,6 +4543,11 @@ func (in *CreateRouteInput) DeepCopyInto(out *CreateRouteInput) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EgressOnlyInternetGatewayRef != nil {
+		in, out := &in.EgressOnlyInternetGatewayRef, &out.EgressOnlyInternetGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GatewayID != nil {
 		in, out := &in.GatewayID, &out.GatewayID
 		*out = new(string)
@@ -4366,6 +4563,11 @@ func (in *CreateRouteInput) DeepCopyInto(out *CreateRouteInput) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LocalGatewayID != nil {
 		in, out := &in.LocalGatewayID, &out.LocalGatewayID
 		*out = new(string)
@@ -4386,6 +4588,11 @@ func (in *CreateRouteInput) DeepCopyInto(out *CreateRouteInput) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NetworkInterfaceRef != nil {
+		in, out := &in.NetworkInterfaceRef, &out.NetworkInterfaceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TransitGatewayID != nil {
 		in, out := &in.TransitGatewayID, &out.TransitGatewayID
 		*out = new(string)
@@ -4428,6 +4635,61 @@ func (in *CreateRouteInput) DeepCopy() *CreateRouteInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreateTransitGatewayPeeringAttachmentRequestOptions) DeepCopyInto(out *CreateTransitGatewayPeeringAttachmentRequestOptions) {
+	*out = *in
+	if in.DynamicRouting != nil {
+		in, out := &in.DynamicRouting, &out.DynamicRouting
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateTransitGatewayPeeringAttachmentRequestOptions.
+func (in *CreateTransitGatewayPeeringAttachmentRequestOptions) DeepCopy() *CreateTransitGatewayPeeringAttachmentRequestOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CreateTransitGatewayPeeringAttachmentRequestOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreateTransitGatewayRouteInput) DeepCopyInto(out *CreateTransitGatewayRouteInput) {
+	*out = *in
+	if in.Blackhole != nil {
+		in, out := &in.Blackhole, &out.Blackhole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentRef != nil {
+		in, out := &in.TransitGatewayAttachmentRef, &out.TransitGatewayAttachmentRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateTransitGatewayRouteInput.
+func (in *CreateTransitGatewayRouteInput) DeepCopy() *CreateTransitGatewayRouteInput {
+	if in == nil {
+		return nil
+	}
+	out := new(CreateTransitGatewayRouteInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CreateTransitGatewayVPCAttachmentRequestOptions) DeepCopyInto(out *CreateTransitGatewayVPCAttachmentRequestOptions) {
 	*out = *in
@@ -4705,6 +4967,162 @@ func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomerGateway) DeepCopyInto(out *CustomerGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway.
+func (in *CustomerGateway) DeepCopy() *CustomerGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayList) DeepCopyInto(out *CustomerGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomerGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayList.
+func (in *CustomerGatewayList) DeepCopy() *CustomerGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewaySpec) DeepCopyInto(out *CustomerGatewaySpec) {
+	*out = *in
+	if in.BGPASN != nil {
+		in, out := &in.BGPASN, &out.BGPASN
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BGPASNExtended != nil {
+		in, out := &in.BGPASNExtended, &out.BGPASNExtended
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CertificateARN != nil {
+		in, out := &in.CertificateARN, &out.CertificateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewaySpec.
+func (in *CustomerGatewaySpec) DeepCopy() *CustomerGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayStatus) DeepCopyInto(out *CustomerGatewayStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CustomerGatewayID != nil {
+		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayStatus.
+func (in *CustomerGatewayStatus) DeepCopy() *CustomerGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGateway_SDK) DeepCopyInto(out *CustomerGateway_SDK) {
 	*out = *in
 	if in.BGPASN != nil {
 		in, out := &in.BGPASN, &out.BGPASN
@@ -4759,12 +5177,12 @@ func (in *CustomerGateway) DeepCopyInto(out *CustomerGateway) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway.
-func (in *CustomerGateway) DeepCopy() *CustomerGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway_SDK.
+func (in *CustomerGateway_SDK) DeepCopy() *CustomerGateway_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomerGateway)
+	out := new(CustomerGateway_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5253,6 +5671,573 @@ func (in *DeclarativePoliciesReport) DeepCopy() *DeclarativePoliciesReport {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultNetworkACL) DeepCopyInto(out *DefaultNetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultNetworkACL.
+func (in *DefaultNetworkACL) DeepCopy() *DefaultNetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultNetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultNetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultNetworkACLList) DeepCopyInto(out *DefaultNetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultNetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultNetworkACLList.
+func (in *DefaultNetworkACLList) DeepCopy() *DefaultNetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultNetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultNetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultNetworkACLSpec) DeepCopyInto(out *DefaultNetworkACLSpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]*NetworkACLEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkACLEntry)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultNetworkACLSpec.
+func (in *DefaultNetworkACLSpec) DeepCopy() *DefaultNetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultNetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultNetworkACLStatus) DeepCopyInto(out *DefaultNetworkACLStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]*NetworkACLAssociation, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkACLAssociation)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultNetworkACLStatus.
+func (in *DefaultNetworkACLStatus) DeepCopy() *DefaultNetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultNetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRouteTable) DeepCopyInto(out *DefaultRouteTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRouteTable.
+func (in *DefaultRouteTable) DeepCopy() *DefaultRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultRouteTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRouteTableList) DeepCopyInto(out *DefaultRouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultRouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRouteTableList.
+func (in *DefaultRouteTableList) DeepCopy() *DefaultRouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultRouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRouteTableSpec) DeepCopyInto(out *DefaultRouteTableSpec) {
+	*out = *in
+	if in.PropagatingVPNGateways != nil {
+		in, out := &in.PropagatingVPNGateways, &out.PropagatingVPNGateways
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.PropagatingVPNGatewayRefs != nil {
+		in, out := &in.PropagatingVPNGatewayRefs, &out.PropagatingVPNGatewayRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*CreateRouteInput, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CreateRouteInput)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRouteTableSpec.
+func (in *DefaultRouteTableSpec) DeepCopy() *DefaultRouteTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRouteTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRouteTableStatus) DeepCopyInto(out *DefaultRouteTableStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]*RouteTableAssociation, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouteTableAssociation)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PropagatingVGWs != nil {
+		in, out := &in.PropagatingVGWs, &out.PropagatingVGWs
+		*out = make([]*PropagatingVGW, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PropagatingVGW)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.RouteFailures != nil {
+		in, out := &in.RouteFailures, &out.RouteFailures
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RouteOwners != nil {
+		in, out := &in.RouteOwners, &out.RouteOwners
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RouteStatuses != nil {
+		in, out := &in.RouteStatuses, &out.RouteStatuses
+		*out = make([]*Route_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Route_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.RouteTableID != nil {
+		in, out := &in.RouteTableID, &out.RouteTableID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UnmanagedRoutes != nil {
+		in, out := &in.UnmanagedRoutes, &out.UnmanagedRoutes
+		*out = make([]*Route_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Route_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRouteTableStatus.
+func (in *DefaultRouteTableStatus) DeepCopy() *DefaultRouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultSecurityGroup) DeepCopyInto(out *DefaultSecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSecurityGroup.
+func (in *DefaultSecurityGroup) DeepCopy() *DefaultSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultSecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultSecurityGroupList) DeepCopyInto(out *DefaultSecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultSecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSecurityGroupList.
+func (in *DefaultSecurityGroupList) DeepCopy() *DefaultSecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultSecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultSecurityGroupSpec) DeepCopyInto(out *DefaultSecurityGroupSpec) {
+	*out = *in
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]*IPPermission, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPPermission)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]*IPPermission, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPPermission)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSecurityGroupSpec.
+func (in *DefaultSecurityGroupSpec) DeepCopy() *DefaultSecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultSecurityGroupStatus) DeepCopyInto(out *DefaultSecurityGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*SecurityGroupRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecurityGroupRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSecurityGroupStatus.
+func (in *DefaultSecurityGroupStatus) DeepCopy() *DefaultSecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteFleetError) DeepCopyInto(out *DeleteFleetError) {
 	*out = *in
@@ -9018,57 +10003,10 @@ func (in *IKEVersionsRequestListValue) DeepCopy() *IKEVersionsRequestListValue {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAM) DeepCopyInto(out *IPAM) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.EnablePrivateGua != nil {
-		in, out := &in.EnablePrivateGua, &out.EnablePrivateGua
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IPAMARN != nil {
-		in, out := &in.IPAMARN, &out.IPAMARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourceDiscoveryAssociationCount != nil {
-		in, out := &in.ResourceDiscoveryAssociationCount, &out.ResourceDiscoveryAssociationCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ScopeCount != nil {
-		in, out := &in.ScopeCount, &out.ScopeCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.StateMessage != nil {
-		in, out := &in.StateMessage, &out.StateMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
@@ -9081,6 +10019,14 @@ func (in *IPAM) DeepCopy() *IPAM {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAM) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMAddressHistoryRecord) DeepCopyInto(out *IPAMAddressHistoryRecord) {
 	*out = *in
@@ -9405,6 +10351,38 @@ func (in *IPAMExternalResourceVerificationToken) DeepCopy() *IPAMExternalResourc
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMList) DeepCopyInto(out *IPAMList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAM, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMList.
+func (in *IPAMList) DeepCopy() *IPAMList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMOperatingRegion) DeepCopyInto(out *IPAMOperatingRegion) {
 	*out = *in
@@ -9574,82 +10552,10 @@ func (in *IPAMPolicyOrganizationTarget) DeepCopy() *IPAMPolicyOrganizationTarget
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
 	*out = *in
-	if in.AutoImport != nil {
-		in, out := &in.AutoImport, &out.AutoImport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMARN != nil {
-		in, out := &in.IPAMARN, &out.IPAMARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMPoolARN != nil {
-		in, out := &in.IPAMPoolARN, &out.IPAMPoolARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMPoolID != nil {
-		in, out := &in.IPAMPoolID, &out.IPAMPoolID
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMScopeARN != nil {
-		in, out := &in.IPAMScopeARN, &out.IPAMScopeARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Locale != nil {
-		in, out := &in.Locale, &out.Locale
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PoolDepth != nil {
-		in, out := &in.PoolDepth, &out.PoolDepth
-		*out = new(int64)
-		**out = **in
-	}
-	if in.PubliclyAdvertisable != nil {
-		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SourceIPAMPoolID != nil {
-		in, out := &in.SourceIPAMPoolID, &out.SourceIPAMPoolID
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateMessage != nil {
-		in, out := &in.StateMessage, &out.StateMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
@@ -9662,6 +10568,14 @@ func (in *IPAMPool) DeepCopy() *IPAMPool {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolAllocation) DeepCopyInto(out *IPAMPoolAllocation) {
 	*out = *in
@@ -9710,11 +10624,26 @@ func (in *IPAMPoolCIDR) DeepCopyInto(out *IPAMPoolCIDR) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(IPAMPoolCIDRFailureReason)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAMPoolCIDRID != nil {
+		in, out := &in.IPAMPoolCIDRID, &out.IPAMPoolCIDRID
+		*out = new(string)
+		**out = **in
+	}
 	if in.NetmaskLength != nil {
 		in, out := &in.NetmaskLength, &out.NetmaskLength
 		*out = new(int64)
 		**out = **in
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDR.
@@ -9730,6 +10659,11 @@ func (in *IPAMPoolCIDR) DeepCopy() *IPAMPoolCIDR {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolCIDRFailureReason) DeepCopyInto(out *IPAMPoolCIDRFailureReason) {
 	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(string)
+		**out = **in
+	}
 	if in.Message != nil {
 		in, out := &in.Message, &out.Message
 		*out = new(string)
@@ -9747,6 +10681,38 @@ func (in *IPAMPoolCIDRFailureReason) DeepCopy() *IPAMPoolCIDRFailureReason {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolList) DeepCopyInto(out *IPAMPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolList.
+func (in *IPAMPoolList) DeepCopy() *IPAMPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolSourceResource) DeepCopyInto(out *IPAMPoolSourceResource) {
 	*out = *in
@@ -9765,6 +10731,11 @@ func (in *IPAMPoolSourceResource) DeepCopyInto(out *IPAMPoolSourceResource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSourceResource.
@@ -9795,6 +10766,11 @@ func (in *IPAMPoolSourceResourceRequest) DeepCopyInto(out *IPAMPoolSourceResourc
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSourceResourceRequest.
@@ -9808,38 +10784,105 @@ func (in *IPAMPoolSourceResourceRequest) DeepCopy() *IPAMPoolSourceResourceReque
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolver) DeepCopyInto(out *IPAMPrefixListResolver) {
+func (in *IPAMPoolSpec) DeepCopyInto(out *IPAMPoolSpec) {
 	*out = *in
+	if in.AddressFamily != nil {
+		in, out := &in.AddressFamily, &out.AddressFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllocationDefaultNetmaskLength != nil {
+		in, out := &in.AllocationDefaultNetmaskLength, &out.AllocationDefaultNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationMaxNetmaskLength != nil {
+		in, out := &in.AllocationMaxNetmaskLength, &out.AllocationMaxNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationMinNetmaskLength != nil {
+		in, out := &in.AllocationMinNetmaskLength, &out.AllocationMinNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationResourceTags != nil {
+		in, out := &in.AllocationResourceTags, &out.AllocationResourceTags
+		*out = make([]*RequestIPAMResourceTag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RequestIPAMResourceTag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutoImport != nil {
+		in, out := &in.AutoImport, &out.AutoImport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSService != nil {
+		in, out := &in.AWSService, &out.AWSService
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPAMARN != nil {
-		in, out := &in.IPAMARN, &out.IPAMARN
+	if in.IPAMScopeID != nil {
+		in, out := &in.IPAMScopeID, &out.IPAMScopeID
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPAMPrefixListResolverARN != nil {
-		in, out := &in.IPAMPrefixListResolverARN, &out.IPAMPrefixListResolverARN
+	if in.IPAMScopeRef != nil {
+		in, out := &in.IPAMScopeRef, &out.IPAMScopeRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Locale != nil {
+		in, out := &in.Locale, &out.Locale
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
+	if in.PublicIPSource != nil {
+		in, out := &in.PublicIPSource, &out.PublicIPSource
 		*out = new(string)
 		**out = **in
 	}
-	if in.LastVersionCreationStatusMessage != nil {
-		in, out := &in.LastVersionCreationStatusMessage, &out.LastVersionCreationStatusMessage
-		*out = new(string)
+	if in.PubliclyAdvertisable != nil {
+		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
+		*out = new(bool)
 		**out = **in
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
+	if in.SourceIPAMPoolID != nil {
+		in, out := &in.SourceIPAMPoolID, &out.SourceIPAMPoolID
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceIPAMPoolRef != nil {
+		in, out := &in.SourceIPAMPoolRef, &out.SourceIPAMPoolRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceResource != nil {
+		in, out := &in.SourceResource, &out.SourceResource
+		*out = new(IPAMPoolSourceResourceRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -9853,41 +10896,37 @@ func (in *IPAMPrefixListResolver) DeepCopyInto(out *IPAMPrefixListResolver) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolver.
-func (in *IPAMPrefixListResolver) DeepCopy() *IPAMPrefixListResolver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSpec.
+func (in *IPAMPoolSpec) DeepCopy() *IPAMPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMPrefixListResolver)
+	out := new(IPAMPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverRule) DeepCopyInto(out *IPAMPrefixListResolverRule) {
+func (in *IPAMPoolStatus) DeepCopyInto(out *IPAMPoolStatus) {
 	*out = *in
-	if in.StaticCIDR != nil {
-		in, out := &in.StaticCIDR, &out.StaticCIDR
-		*out = new(string)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRule.
-func (in *IPAMPrefixListResolverRule) DeepCopy() *IPAMPrefixListResolverRule {
-	if in == nil {
-		return nil
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	out := new(IPAMPrefixListResolverRule)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverRuleCondition) DeepCopyInto(out *IPAMPrefixListResolverRuleCondition) {
-	*out = *in
-	if in.CIDR != nil {
-		in, out := &in.CIDR, &out.CIDR
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
 		*out = new(string)
 		**out = **in
 	}
@@ -9896,98 +10935,145 @@ func (in *IPAMPrefixListResolverRuleCondition) DeepCopyInto(out *IPAMPrefixListR
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceOwner != nil {
-		in, out := &in.ResourceOwner, &out.ResourceOwner
+	if in.IPAMScopeARN != nil {
+		in, out := &in.IPAMScopeARN, &out.IPAMScopeARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceRegion != nil {
-		in, out := &in.ResourceRegion, &out.ResourceRegion
+	if in.IPAMScopeType != nil {
+		in, out := &in.IPAMScopeType, &out.IPAMScopeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoolDepth != nil {
+		in, out := &in.PoolDepth, &out.PoolDepth
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProvisionedCIDRs != nil {
+		in, out := &in.ProvisionedCIDRs, &out.ProvisionedCIDRs
+		*out = make([]*IPAMPoolCIDR, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPAMPoolCIDR)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateMessage != nil {
+		in, out := &in.StateMessage, &out.StateMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleCondition.
-func (in *IPAMPrefixListResolverRuleCondition) DeepCopy() *IPAMPrefixListResolverRuleCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolStatus.
+func (in *IPAMPoolStatus) DeepCopy() *IPAMPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMPrefixListResolverRuleCondition)
+	out := new(IPAMPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverRuleConditionRequest) DeepCopyInto(out *IPAMPrefixListResolverRuleConditionRequest) {
+func (in *IPAMPool_SDK) DeepCopyInto(out *IPAMPool_SDK) {
 	*out = *in
-	if in.CIDR != nil {
-		in, out := &in.CIDR, &out.CIDR
+	if in.AddressFamily != nil {
+		in, out := &in.AddressFamily, &out.AddressFamily
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPAMPoolID != nil {
-		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+	if in.AllocationDefaultNetmaskLength != nil {
+		in, out := &in.AllocationDefaultNetmaskLength, &out.AllocationDefaultNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationMaxNetmaskLength != nil {
+		in, out := &in.AllocationMaxNetmaskLength, &out.AllocationMaxNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationMinNetmaskLength != nil {
+		in, out := &in.AllocationMinNetmaskLength, &out.AllocationMinNetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllocationResourceTags != nil {
+		in, out := &in.AllocationResourceTags, &out.AllocationResourceTags
+		*out = make([]*IPAMResourceTag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPAMResourceTag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutoImport != nil {
+		in, out := &in.AutoImport, &out.AutoImport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSService != nil {
+		in, out := &in.AWSService, &out.AWSService
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceOwner != nil {
-		in, out := &in.ResourceOwner, &out.ResourceOwner
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceRegion != nil {
-		in, out := &in.ResourceRegion, &out.ResourceRegion
+	if in.IPAMPoolARN != nil {
+		in, out := &in.IPAMPoolARN, &out.IPAMPoolARN
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleConditionRequest.
-func (in *IPAMPrefixListResolverRuleConditionRequest) DeepCopy() *IPAMPrefixListResolverRuleConditionRequest {
-	if in == nil {
-		return nil
+	if in.IPAMPoolID != nil {
+		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+		*out = new(string)
+		**out = **in
 	}
-	out := new(IPAMPrefixListResolverRuleConditionRequest)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverRuleRequest) DeepCopyInto(out *IPAMPrefixListResolverRuleRequest) {
-	*out = *in
-	if in.StaticCIDR != nil {
-		in, out := &in.StaticCIDR, &out.StaticCIDR
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleRequest.
-func (in *IPAMPrefixListResolverRuleRequest) DeepCopy() *IPAMPrefixListResolverRuleRequest {
-	if in == nil {
-		return nil
+	if in.IPAMScopeARN != nil {
+		in, out := &in.IPAMScopeARN, &out.IPAMScopeARN
+		*out = new(string)
+		**out = **in
 	}
-	out := new(IPAMPrefixListResolverRuleRequest)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverTarget) DeepCopyInto(out *IPAMPrefixListResolverTarget) {
-	*out = *in
-	if in.IPAMPrefixListResolverTargetARN != nil {
-		in, out := &in.IPAMPrefixListResolverTargetARN, &out.IPAMPrefixListResolverTargetARN
+	if in.IPAMScopeType != nil {
+		in, out := &in.IPAMScopeType, &out.IPAMScopeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Locale != nil {
+		in, out := &in.Locale, &out.Locale
 		*out = new(string)
 		**out = **in
 	}
@@ -9996,13 +11082,33 @@ func (in *IPAMPrefixListResolverTarget) DeepCopyInto(out *IPAMPrefixListResolver
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrefixListID != nil {
-		in, out := &in.PrefixListID, &out.PrefixListID
+	if in.PoolDepth != nil {
+		in, out := &in.PoolDepth, &out.PoolDepth
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PublicIPSource != nil {
+		in, out := &in.PublicIPSource, &out.PublicIPSource
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrefixListRegion != nil {
-		in, out := &in.PrefixListRegion, &out.PrefixListRegion
+	if in.PubliclyAdvertisable != nil {
+		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SourceIPAMPoolID != nil {
+		in, out := &in.SourceIPAMPoolID, &out.SourceIPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceResource != nil {
+		in, out := &in.SourceResource, &out.SourceResource
+		*out = new(IPAMPoolSourceResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
@@ -10022,38 +11128,265 @@ func (in *IPAMPrefixListResolverTarget) DeepCopyInto(out *IPAMPrefixListResolver
 			}
 		}
 	}
-	if in.TrackLatestVersion != nil {
-		in, out := &in.TrackLatestVersion, &out.TrackLatestVersion
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverTarget.
-func (in *IPAMPrefixListResolverTarget) DeepCopy() *IPAMPrefixListResolverTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool_SDK.
+func (in *IPAMPool_SDK) DeepCopy() *IPAMPool_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMPrefixListResolverTarget)
+	out := new(IPAMPool_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPrefixListResolverVersion) DeepCopyInto(out *IPAMPrefixListResolverVersion) {
+func (in *IPAMPrefixListResolver) DeepCopyInto(out *IPAMPrefixListResolver) {
 	*out = *in
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(int64)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverVersion.
-func (in *IPAMPrefixListResolverVersion) DeepCopy() *IPAMPrefixListResolverVersion {
-	if in == nil {
-		return nil
-	}
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPrefixListResolverARN != nil {
+		in, out := &in.IPAMPrefixListResolverARN, &out.IPAMPrefixListResolverARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastVersionCreationStatusMessage != nil {
+		in, out := &in.LastVersionCreationStatusMessage, &out.LastVersionCreationStatusMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolver.
+func (in *IPAMPrefixListResolver) DeepCopy() *IPAMPrefixListResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverRule) DeepCopyInto(out *IPAMPrefixListResolverRule) {
+	*out = *in
+	if in.StaticCIDR != nil {
+		in, out := &in.StaticCIDR, &out.StaticCIDR
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRule.
+func (in *IPAMPrefixListResolverRule) DeepCopy() *IPAMPrefixListResolverRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolverRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverRuleCondition) DeepCopyInto(out *IPAMPrefixListResolverRuleCondition) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPoolID != nil {
+		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceOwner != nil {
+		in, out := &in.ResourceOwner, &out.ResourceOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceRegion != nil {
+		in, out := &in.ResourceRegion, &out.ResourceRegion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleCondition.
+func (in *IPAMPrefixListResolverRuleCondition) DeepCopy() *IPAMPrefixListResolverRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolverRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverRuleConditionRequest) DeepCopyInto(out *IPAMPrefixListResolverRuleConditionRequest) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPoolID != nil {
+		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceOwner != nil {
+		in, out := &in.ResourceOwner, &out.ResourceOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceRegion != nil {
+		in, out := &in.ResourceRegion, &out.ResourceRegion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleConditionRequest.
+func (in *IPAMPrefixListResolverRuleConditionRequest) DeepCopy() *IPAMPrefixListResolverRuleConditionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolverRuleConditionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverRuleRequest) DeepCopyInto(out *IPAMPrefixListResolverRuleRequest) {
+	*out = *in
+	if in.StaticCIDR != nil {
+		in, out := &in.StaticCIDR, &out.StaticCIDR
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverRuleRequest.
+func (in *IPAMPrefixListResolverRuleRequest) DeepCopy() *IPAMPrefixListResolverRuleRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolverRuleRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverTarget) DeepCopyInto(out *IPAMPrefixListResolverTarget) {
+	*out = *in
+	if in.IPAMPrefixListResolverTargetARN != nil {
+		in, out := &in.IPAMPrefixListResolverTargetARN, &out.IPAMPrefixListResolverTargetARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListRegion != nil {
+		in, out := &in.PrefixListRegion, &out.PrefixListRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateMessage != nil {
+		in, out := &in.StateMessage, &out.StateMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TrackLatestVersion != nil {
+		in, out := &in.TrackLatestVersion, &out.TrackLatestVersion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverTarget.
+func (in *IPAMPrefixListResolverTarget) DeepCopy() *IPAMPrefixListResolverTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPrefixListResolverTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPrefixListResolverVersion) DeepCopyInto(out *IPAMPrefixListResolverVersion) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPrefixListResolverVersion.
+func (in *IPAMPrefixListResolverVersion) DeepCopy() *IPAMPrefixListResolverVersion {
+	if in == nil {
+		return nil
+	}
 	out := new(IPAMPrefixListResolverVersion)
 	in.DeepCopyInto(out)
 	return out
@@ -10314,52 +11647,10 @@ func (in *IPAMResourceTag) DeepCopy() *IPAMResourceTag {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMScope) DeepCopyInto(out *IPAMScope) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMARN != nil {
-		in, out := &in.IPAMARN, &out.IPAMARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMScopeARN != nil {
-		in, out := &in.IPAMScopeARN, &out.IPAMScopeARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PoolCount != nil {
-		in, out := &in.PoolCount, &out.PoolCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScope.
@@ -10372,6 +11663,14 @@ func (in *IPAMScope) DeepCopy() *IPAMScope {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMScope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMScopeExternalAuthorityConfiguration) DeepCopyInto(out *IPAMScopeExternalAuthorityConfiguration) {
 	*out = *in
@@ -10393,229 +11692,709 @@ func (in *IPAMScopeExternalAuthorityConfiguration) DeepCopy() *IPAMScopeExternal
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPPermission) DeepCopyInto(out *IPPermission) {
+func (in *IPAMScopeList) DeepCopyInto(out *IPAMScopeList) {
 	*out = *in
-	if in.FromPort != nil {
-		in, out := &in.FromPort, &out.FromPort
-		*out = new(int64)
-		**out = **in
-	}
-	if in.IPProtocol != nil {
-		in, out := &in.IPProtocol, &out.IPProtocol
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPRanges != nil {
-		in, out := &in.IPRanges, &out.IPRanges
-		*out = make([]*IPRange, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(IPRange)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.IPv6Ranges != nil {
-		in, out := &in.IPv6Ranges, &out.IPv6Ranges
-		*out = make([]*IPv6Range, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(IPv6Range)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.PrefixListIDs != nil {
-		in, out := &in.PrefixListIDs, &out.PrefixListIDs
-		*out = make([]*PrefixListID, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(PrefixListID)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ToPort != nil {
-		in, out := &in.ToPort, &out.ToPort
-		*out = new(int64)
-		**out = **in
-	}
-	if in.UserIDGroupPairs != nil {
-		in, out := &in.UserIDGroupPairs, &out.UserIDGroupPairs
-		*out = make([]*UserIDGroupPair, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMScope, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(UserIDGroupPair)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPermission.
-func (in *IPPermission) DeepCopy() *IPPermission {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeList.
+func (in *IPAMScopeList) DeepCopy() *IPAMScopeList {
 	if in == nil {
 		return nil
 	}
-	out := new(IPPermission)
+	out := new(IPAMScopeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMScopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPRange) DeepCopyInto(out *IPRange) {
+func (in *IPAMScopeSpec) DeepCopyInto(out *IPAMScopeSpec) {
 	*out = *in
-	if in.CIDRIP != nil {
-		in, out := &in.CIDRIP, &out.CIDRIP
-		*out = new(string)
-		**out = **in
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
-func (in *IPRange) DeepCopy() *IPRange {
-	if in == nil {
-		return nil
-	}
-	out := new(IPRange)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv4PrefixSpecification) DeepCopyInto(out *IPv4PrefixSpecification) {
-	*out = *in
-	if in.IPv4Prefix != nil {
-		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+	if in.IPAMID != nil {
+		in, out := &in.IPAMID, &out.IPAMID
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPAMRef != nil {
+		in, out := &in.IPAMRef, &out.IPAMRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecification.
-func (in *IPv4PrefixSpecification) DeepCopy() *IPv4PrefixSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeSpec.
+func (in *IPAMScopeSpec) DeepCopy() *IPAMScopeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPv4PrefixSpecification)
+	out := new(IPAMScopeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv4PrefixSpecificationRequest) DeepCopyInto(out *IPv4PrefixSpecificationRequest) {
+func (in *IPAMScopeStatus) DeepCopyInto(out *IPAMScopeStatus) {
 	*out = *in
-	if in.IPv4Prefix != nil {
-		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecificationRequest.
-func (in *IPv4PrefixSpecificationRequest) DeepCopy() *IPv4PrefixSpecificationRequest {
-	if in == nil {
-		return nil
-	}
-	out := new(IPv4PrefixSpecificationRequest)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv4PrefixSpecificationResponse) DeepCopyInto(out *IPv4PrefixSpecificationResponse) {
-	*out = *in
-	if in.IPv4Prefix != nil {
-		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecificationResponse.
-func (in *IPv4PrefixSpecificationResponse) DeepCopy() *IPv4PrefixSpecificationResponse {
-	if in == nil {
-		return nil
+	if in.IPAMScopeID != nil {
+		in, out := &in.IPAMScopeID, &out.IPAMScopeID
+		*out = new(string)
+		**out = **in
 	}
-	out := new(IPv4PrefixSpecificationResponse)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv6CIDRAssociation) DeepCopyInto(out *IPv6CIDRAssociation) {
-	*out = *in
-	if in.AssociatedResource != nil {
-		in, out := &in.AssociatedResource, &out.AssociatedResource
+	if in.IPAMScopeType != nil {
+		in, out := &in.IPAMScopeType, &out.IPAMScopeType
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPv6CIDR != nil {
-		in, out := &in.IPv6CIDR, &out.IPv6CIDR
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6CIDRAssociation.
-func (in *IPv6CIDRAssociation) DeepCopy() *IPv6CIDRAssociation {
-	if in == nil {
-		return nil
+	if in.PoolCount != nil {
+		in, out := &in.PoolCount, &out.PoolCount
+		*out = new(int64)
+		**out = **in
 	}
-	out := new(IPv6CIDRAssociation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv6CIDRBlock) DeepCopyInto(out *IPv6CIDRBlock) {
-	*out = *in
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6CIDRBlock.
-func (in *IPv6CIDRBlock) DeepCopy() *IPv6CIDRBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeStatus.
+func (in *IPAMScopeStatus) DeepCopy() *IPAMScopeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPv6CIDRBlock)
+	out := new(IPAMScopeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv6Pool) DeepCopyInto(out *IPv6Pool) {
+func (in *IPAMScope_SDK) DeepCopyInto(out *IPAMScope_SDK) {
 	*out = *in
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.PoolID != nil {
-		in, out := &in.PoolID, &out.PoolID
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMScopeARN != nil {
+		in, out := &in.IPAMScopeARN, &out.IPAMScopeARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMScopeID != nil {
+		in, out := &in.IPAMScopeID, &out.IPAMScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMScopeType != nil {
+		in, out := &in.IPAMScopeType, &out.IPAMScopeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoolCount != nil {
+		in, out := &in.PoolCount, &out.PoolCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScope_SDK.
+func (in *IPAMScope_SDK) DeepCopy() *IPAMScope_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMScope_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnablePrivateGua != nil {
+		in, out := &in.EnablePrivateGua, &out.EnablePrivateGua
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MeteredAccount != nil {
+		in, out := &in.MeteredAccount, &out.MeteredAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatingRegions != nil {
+		in, out := &in.OperatingRegions, &out.OperatingRegions
+		*out = make([]*AddIPAMOperatingRegion, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AddIPAMOperatingRegion)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
+func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DefaultResourceDiscoveryAssociationID != nil {
+		in, out := &in.DefaultResourceDiscoveryAssociationID, &out.DefaultResourceDiscoveryAssociationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultResourceDiscoveryID != nil {
+		in, out := &in.DefaultResourceDiscoveryID, &out.DefaultResourceDiscoveryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMID != nil {
+		in, out := &in.IPAMID, &out.IPAMID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDefaultScopeID != nil {
+		in, out := &in.PrivateDefaultScopeID, &out.PrivateDefaultScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicDefaultScopeID != nil {
+		in, out := &in.PublicDefaultScopeID, &out.PublicDefaultScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceDiscoveryAssociationCount != nil {
+		in, out := &in.ResourceDiscoveryAssociationCount, &out.ResourceDiscoveryAssociationCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScopeCount != nil {
+		in, out := &in.ScopeCount, &out.ScopeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateMessage != nil {
+		in, out := &in.StateMessage, &out.StateMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMStatus.
+func (in *IPAMStatus) DeepCopy() *IPAMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAM_SDK) DeepCopyInto(out *IPAM_SDK) {
+	*out = *in
+	if in.DefaultResourceDiscoveryAssociationID != nil {
+		in, out := &in.DefaultResourceDiscoveryAssociationID, &out.DefaultResourceDiscoveryAssociationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultResourceDiscoveryID != nil {
+		in, out := &in.DefaultResourceDiscoveryID, &out.DefaultResourceDiscoveryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnablePrivateGua != nil {
+		in, out := &in.EnablePrivateGua, &out.EnablePrivateGua
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPAMARN != nil {
+		in, out := &in.IPAMARN, &out.IPAMARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMID != nil {
+		in, out := &in.IPAMID, &out.IPAMID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMRegion != nil {
+		in, out := &in.IPAMRegion, &out.IPAMRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.MeteredAccount != nil {
+		in, out := &in.MeteredAccount, &out.MeteredAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatingRegions != nil {
+		in, out := &in.OperatingRegions, &out.OperatingRegions
+		*out = make([]*IPAMOperatingRegion, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPAMOperatingRegion)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDefaultScopeID != nil {
+		in, out := &in.PrivateDefaultScopeID, &out.PrivateDefaultScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicDefaultScopeID != nil {
+		in, out := &in.PublicDefaultScopeID, &out.PublicDefaultScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceDiscoveryAssociationCount != nil {
+		in, out := &in.ResourceDiscoveryAssociationCount, &out.ResourceDiscoveryAssociationCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScopeCount != nil {
+		in, out := &in.ScopeCount, &out.ScopeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateMessage != nil {
+		in, out := &in.StateMessage, &out.StateMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM_SDK.
+func (in *IPAM_SDK) DeepCopy() *IPAM_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAM_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPermission) DeepCopyInto(out *IPPermission) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IPProtocol != nil {
+		in, out := &in.IPProtocol, &out.IPProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]*IPRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IPv6Ranges != nil {
+		in, out := &in.IPv6Ranges, &out.IPv6Ranges
+		*out = make([]*IPv6Range, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPv6Range)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]*PrefixListID, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PrefixListID)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UserIDGroupPairs != nil {
+		in, out := &in.UserIDGroupPairs, &out.UserIDGroupPairs
+		*out = make([]*UserIDGroupPair, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserIDGroupPair)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPermission.
+func (in *IPPermission) DeepCopy() *IPPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+	if in.CIDRIP != nil {
+		in, out := &in.CIDRIP, &out.CIDRIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4PrefixSpecification) DeepCopyInto(out *IPv4PrefixSpecification) {
+	*out = *in
+	if in.IPv4Prefix != nil {
+		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecification.
+func (in *IPv4PrefixSpecification) DeepCopy() *IPv4PrefixSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4PrefixSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4PrefixSpecificationRequest) DeepCopyInto(out *IPv4PrefixSpecificationRequest) {
+	*out = *in
+	if in.IPv4Prefix != nil {
+		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecificationRequest.
+func (in *IPv4PrefixSpecificationRequest) DeepCopy() *IPv4PrefixSpecificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4PrefixSpecificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4PrefixSpecificationResponse) DeepCopyInto(out *IPv4PrefixSpecificationResponse) {
+	*out = *in
+	if in.IPv4Prefix != nil {
+		in, out := &in.IPv4Prefix, &out.IPv4Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4PrefixSpecificationResponse.
+func (in *IPv4PrefixSpecificationResponse) DeepCopy() *IPv4PrefixSpecificationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4PrefixSpecificationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6CIDRAssociation) DeepCopyInto(out *IPv6CIDRAssociation) {
+	*out = *in
+	if in.AssociatedResource != nil {
+		in, out := &in.AssociatedResource, &out.AssociatedResource
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDR != nil {
+		in, out := &in.IPv6CIDR, &out.IPv6CIDR
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6CIDRAssociation.
+func (in *IPv6CIDRAssociation) DeepCopy() *IPv6CIDRAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6CIDRAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6CIDRBlock) DeepCopyInto(out *IPv6CIDRBlock) {
+	*out = *in
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6CIDRBlock.
+func (in *IPv6CIDRBlock) DeepCopy() *IPv6CIDRBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6CIDRBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6Pool) DeepCopyInto(out *IPv6Pool) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoolID != nil {
+		in, out := &in.PoolID, &out.PoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -13461,6 +15240,11 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LaunchTemplate != nil {
 		in, out := &in.LaunchTemplate, &out.LaunchTemplate
 		*out = new(LaunchTemplateSpecification)
@@ -14764,6 +16548,33 @@ func (in *InterruptionInfo) DeepCopy() *InterruptionInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyPairInfo) DeepCopyInto(out *KeyPairInfo) {
 	*out = *in
@@ -14814,6 +16625,140 @@ func (in *KeyPairInfo) DeepCopy() *KeyPairInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
+	*out = *in
+	if in.KeyFormat != nil {
+		in, out := &in.KeyFormat, &out.KeyFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateKeySecretRef != nil {
+		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.PublicKeyConfigMapRef != nil {
+		in, out := &in.PublicKeyConfigMapRef, &out.PublicKeyConfigMapRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicKeyMaterial != nil {
+		in, out := &in.PublicKeyMaterial, &out.PublicKeyMaterial
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.KeyFingerprint != nil {
+		in, out := &in.KeyFingerprint, &out.KeyFingerprint
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPairID != nil {
+		in, out := &in.KeyPairID, &out.KeyPairID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LastError) DeepCopyInto(out *LastError) {
 	*out = *in
@@ -16268,6 +18213,11 @@ func (in *LaunchTemplatePlacementRequest) DeepCopyInto(out *LaunchTemplatePlacem
 		*out = new(string)
 		**out = **in
 	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HostID != nil {
 		in, out := &in.HostID, &out.HostID
 		*out = new(string)
@@ -19182,128 +21132,10 @@ func (in *NetworkInsightsPath) DeepCopy() *NetworkInsightsPath {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 	*out = *in
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.AvailabilityZoneID != nil {
-		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
-		*out = new(string)
-		**out = **in
-	}
-	if in.DenyAllIgwTraffic != nil {
-		in, out := &in.DenyAllIgwTraffic, &out.DenyAllIgwTraffic
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]*GroupIdentifier, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(GroupIdentifier)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.IPv6Address != nil {
-		in, out := &in.IPv6Address, &out.IPv6Address
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6Native != nil {
-		in, out := &in.IPv6Native, &out.IPv6Native
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Operator != nil {
-		in, out := &in.Operator, &out.Operator
-		*out = new(OperatorResponse)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.OutpostARN != nil {
-		in, out := &in.OutpostARN, &out.OutpostARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicDNSName != nil {
-		in, out := &in.PublicDNSName, &out.PublicDNSName
-		*out = new(string)
-		**out = **in
-	}
-	if in.RequesterID != nil {
-		in, out := &in.RequesterID, &out.RequesterID
-		*out = new(string)
-		**out = **in
-	}
-	if in.RequesterManaged != nil {
-		in, out := &in.RequesterManaged, &out.RequesterManaged
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SourceDestCheck != nil {
-		in, out := &in.SourceDestCheck, &out.SourceDestCheck
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
-		**out = **in
-	}
-	if in.TagSet != nil {
-		in, out := &in.TagSet, &out.TagSet
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
@@ -19316,6 +21148,14 @@ func (in *NetworkInterface) DeepCopy() *NetworkInterface {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceAssociation) DeepCopyInto(out *NetworkInterfaceAssociation) {
 	*out = *in
@@ -19535,6 +21375,38 @@ func (in *NetworkInterfaceIPv6Address) DeepCopy() *NetworkInterfaceIPv6Address {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceList) DeepCopyInto(out *NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceList.
+func (in *NetworkInterfaceList) DeepCopy() *NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfacePermission) DeepCopyInto(out *NetworkInterfacePermission) {
 	*out = *in
@@ -19620,6 +21492,513 @@ func (in *NetworkInterfacePrivateIPAddress) DeepCopy() *NetworkInterfacePrivateI
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4Prefixes != nil {
+		in, out := &in.IPv4Prefixes, &out.IPv4Prefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.IPv6Prefixes != nil {
+		in, out := &in.IPv6Prefixes, &out.IPv6Prefixes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryPrivateIPAddresses != nil {
+		in, out := &in.SecondaryPrivateIPAddresses, &out.SecondaryPrivateIPAddresses
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AssociatedSubnets != nil {
+		in, out := &in.AssociatedSubnets, &out.AssociatedSubnets
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(NetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attachment != nil {
+		in, out := &in.Attachment, &out.Attachment
+		*out = new(NetworkInterfaceAttachment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionTrackingConfiguration != nil {
+		in, out := &in.ConnectionTrackingConfiguration, &out.ConnectionTrackingConfiguration
+		*out = new(ConnectionTrackingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DenyAllIgwTraffic != nil {
+		in, out := &in.DenyAllIgwTraffic, &out.DenyAllIgwTraffic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*GroupIdentifier, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GroupIdentifier)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IPv6Address != nil {
+		in, out := &in.IPv6Address, &out.IPv6Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Native != nil {
+		in, out := &in.IPv6Native, &out.IPv6Native
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]*NetworkInterfacePrivateIPAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkInterfacePrivateIPAddress)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPDNSNameOptions != nil {
+		in, out := &in.PublicIPDNSNameOptions, &out.PublicIPDNSNameOptions
+		*out = new(PublicIPDNSNameOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequesterID != nil {
+		in, out := &in.RequesterID, &out.RequesterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequesterManaged != nil {
+		in, out := &in.RequesterManaged, &out.RequesterManaged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface_SDK) DeepCopyInto(out *NetworkInterface_SDK) {
+	*out = *in
+	if in.AssociatedSubnets != nil {
+		in, out := &in.AssociatedSubnets, &out.AssociatedSubnets
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(NetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attachment != nil {
+		in, out := &in.Attachment, &out.Attachment
+		*out = new(NetworkInterfaceAttachment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionTrackingConfiguration != nil {
+		in, out := &in.ConnectionTrackingConfiguration, &out.ConnectionTrackingConfiguration
+		*out = new(ConnectionTrackingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DenyAllIgwTraffic != nil {
+		in, out := &in.DenyAllIgwTraffic, &out.DenyAllIgwTraffic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]*GroupIdentifier, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GroupIdentifier)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4Prefixes != nil {
+		in, out := &in.IPv4Prefixes, &out.IPv4Prefixes
+		*out = make([]*IPv4PrefixSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPv4PrefixSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IPv6Address != nil {
+		in, out := &in.IPv6Address, &out.IPv6Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]*NetworkInterfaceIPv6Address, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkInterfaceIPv6Address)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IPv6Native != nil {
+		in, out := &in.IPv6Native, &out.IPv6Native
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6Prefixes != nil {
+		in, out := &in.IPv6Prefixes, &out.IPv6Prefixes
+		*out = make([]*IPv6PrefixSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPv6PrefixSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]*NetworkInterfacePrivateIPAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NetworkInterfacePrivateIPAddress)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPDNSNameOptions != nil {
+		in, out := &in.PublicIPDNSNameOptions, &out.PublicIPDNSNameOptions
+		*out = new(PublicIPDNSNameOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequesterID != nil {
+		in, out := &in.RequesterID, &out.RequesterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequesterManaged != nil {
+		in, out := &in.RequesterManaged, &out.RequesterManaged
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface_SDK.
+func (in *NetworkInterface_SDK) DeepCopy() *NetworkInterface_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NewDHCPConfiguration) DeepCopyInto(out *NewDHCPConfiguration) {
 	*out = *in
@@ -20472,6 +22851,11 @@ func (in *Placement) DeepCopyInto(out *Placement) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HostID != nil {
 		in, out := &in.HostID, &out.HostID
 		*out = new(string)
@@ -20512,13 +22896,169 @@ func (in *Placement) DeepCopy() *Placement {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
 	*out = *in
-	if in.GroupARN != nil {
-		in, out := &in.GroupARN, &out.GroupARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
+func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+	if in == nil {
+		return nil
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
+	out := new(PlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupList) DeepCopyInto(out *PlacementGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupList.
+func (in *PlacementGroupList) DeepCopy() *PlacementGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupSpec) DeepCopyInto(out *PlacementGroupSpec) {
+	*out = *in
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionCount != nil {
+		in, out := &in.PartitionCount, &out.PartitionCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SpreadLevel != nil {
+		in, out := &in.SpreadLevel, &out.SpreadLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupSpec.
+func (in *PlacementGroupSpec) DeepCopy() *PlacementGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupStatus) DeepCopyInto(out *PlacementGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GroupARN != nil {
+		in, out := &in.GroupARN, &out.GroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupStatus.
+func (in *PlacementGroupStatus) DeepCopy() *PlacementGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroup_SDK) DeepCopyInto(out *PlacementGroup_SDK) {
+	*out = *in
+	if in.GroupARN != nil {
+		in, out := &in.GroupARN, &out.GroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
 		*out = new(string)
 		**out = **in
 	}
@@ -20555,12 +23095,12 @@ func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
-func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup_SDK.
+func (in *PlacementGroup_SDK) DeepCopy() *PlacementGroup_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(PlacementGroup)
+	out := new(PlacementGroup_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -21767,6 +24307,11 @@ func (in *RequestLaunchTemplateData) DeepCopyInto(out *RequestLaunchTemplateData
 		*out = new(string)
 		**out = **in
 	}
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LicenseSpecifications != nil {
 		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
 		*out = make([]*LaunchTemplateLicenseConfigurationRequest, len(*in))
@@ -22797,86 +25342,10 @@ func (in *RevokedSecurityGroupRule) DeepCopy() *RevokedSecurityGroupRule {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
-	if in.CarrierGatewayID != nil {
-		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CoreNetworkARN != nil {
-		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.DestinationCIDRBlock != nil {
-		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.DestinationIPv6CIDRBlock != nil {
-		in, out := &in.DestinationIPv6CIDRBlock, &out.DestinationIPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.DestinationPrefixListID != nil {
-		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
-		*out = new(string)
-		**out = **in
-	}
-	if in.EgressOnlyInternetGatewayID != nil {
-		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.GatewayID != nil {
-		in, out := &in.GatewayID, &out.GatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.InstanceID != nil {
-		in, out := &in.InstanceID, &out.InstanceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.InstanceOwnerID != nil {
-		in, out := &in.InstanceOwnerID, &out.InstanceOwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LocalGatewayID != nil {
-		in, out := &in.LocalGatewayID, &out.LocalGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.NATGatewayID != nil {
-		in, out := &in.NATGatewayID, &out.NATGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Origin != nil {
-		in, out := &in.Origin, &out.Origin
-		*out = new(string)
-		**out = **in
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayID != nil {
-		in, out := &in.TransitGatewayID, &out.TransitGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPCPeeringConnectionID != nil {
-		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
@@ -22889,6 +25358,46 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteServer) DeepCopyInto(out *RouteServer) {
 	*out = *in
@@ -23172,6 +25681,187 @@ func (in *RouteServerRouteInstallationDetail) DeepCopy() *RouteServerRouteInstal
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.CarrierGatewayID != nil {
+		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CarrierGatewayRef != nil {
+		in, out := &in.CarrierGatewayRef, &out.CarrierGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CoreNetworkARN != nil {
+		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationIPv6CIDRBlock != nil {
+		in, out := &in.DestinationIPv6CIDRBlock, &out.DestinationIPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGatewayID != nil {
+		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGatewayRef != nil {
+		in, out := &in.EgressOnlyInternetGatewayRef, &out.EgressOnlyInternetGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayID != nil {
+		in, out := &in.GatewayID, &out.GatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayRef != nil {
+		in, out := &in.GatewayRef, &out.GatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalGatewayID != nil {
+		in, out := &in.LocalGatewayID, &out.LocalGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGatewayID != nil {
+		in, out := &in.NATGatewayID, &out.NATGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGatewayRef != nil {
+		in, out := &in.NATGatewayRef, &out.NATGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceRef != nil {
+		in, out := &in.NetworkInterfaceRef, &out.NetworkInterfaceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteTableID != nil {
+		in, out := &in.RouteTableID, &out.RouteTableID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteTableRef != nil {
+		in, out := &in.RouteTableRef, &out.RouteTableRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRef != nil {
+		in, out := &in.TransitGatewayRef, &out.TransitGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpointID != nil {
+		in, out := &in.VPCEndpointID, &out.VPCEndpointID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCEndpointRef != nil {
+		in, out := &in.VPCEndpointRef, &out.VPCEndpointRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionRef != nil {
+		in, out := &in.VPCPeeringConnectionRef, &out.VPCPeeringConnectionRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -23304,6 +25994,59 @@ func (in *RouteTableList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 	*out = *in
+	if in.BlackholeRoutePolicy != nil {
+		in, out := &in.BlackholeRoutePolicy, &out.BlackholeRoutePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayID != nil {
+		in, out := &in.GatewayID, &out.GatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayRef != nil {
+		in, out := &in.GatewayRef, &out.GatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoredRouteDestinations != nil {
+		in, out := &in.IgnoredRouteDestinations, &out.IgnoredRouteDestinations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Main != nil {
+		in, out := &in.Main, &out.Main
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PropagatingVPNGateways != nil {
+		in, out := &in.PropagatingVPNGateways, &out.PropagatingVPNGateways
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.PropagatingVPNGatewayRefs != nil {
+		in, out := &in.PropagatingVPNGatewayRefs, &out.PropagatingVPNGatewayRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make([]*CreateRouteInput, len(*in))
@@ -23326,6 +26069,11 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 			}
 		}
 	}
+	if in.UnmanagedRoutePolicy != nil {
+		in, out := &in.UnmanagedRoutePolicy, &out.UnmanagedRoutePolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.VPCID != nil {
 		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
@@ -23336,6 +26084,16 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNGatewayRef != nil {
+		in, out := &in.VPNGatewayRef, &out.VPNGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
@@ -23378,6 +26136,11 @@ func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 			}
 		}
 	}
+	if in.OriginalMainRouteTableID != nil {
+		in, out := &in.OriginalMainRouteTableID, &out.OriginalMainRouteTableID
+		*out = new(string)
+		**out = **in
+	}
 	if in.OwnerID != nil {
 		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
@@ -23394,13 +26157,45 @@ func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 			}
 		}
 	}
+	if in.RouteFailures != nil {
+		in, out := &in.RouteFailures, &out.RouteFailures
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.RouteOwners != nil {
+		in, out := &in.RouteOwners, &out.RouteOwners
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.RouteStatuses != nil {
 		in, out := &in.RouteStatuses, &out.RouteStatuses
-		*out = make([]*Route, len(*in))
+		*out = make([]*Route_SDK, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Route)
+				*out = new(Route_SDK)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -23410,6 +26205,17 @@ func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.UnmanagedRoutes != nil {
+		in, out := &in.UnmanagedRoutes, &out.UnmanagedRoutes
+		*out = make([]*Route_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Route_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
@@ -23459,11 +26265,11 @@ func (in *RouteTable_SDK) DeepCopyInto(out *RouteTable_SDK) {
 	}
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
-		*out = make([]*Route, len(*in))
+		*out = make([]*Route_SDK, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Route)
+				*out = new(Route_SDK)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -23496,6 +26302,101 @@ func (in *RouteTable_SDK) DeepCopy() *RouteTable_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route_SDK) DeepCopyInto(out *Route_SDK) {
+	*out = *in
+	if in.CarrierGatewayID != nil {
+		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CoreNetworkARN != nil {
+		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationIPv6CIDRBlock != nil {
+		in, out := &in.DestinationIPv6CIDRBlock, &out.DestinationIPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGatewayID != nil {
+		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayID != nil {
+		in, out := &in.GatewayID, &out.GatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceOwnerID != nil {
+		in, out := &in.InstanceOwnerID, &out.InstanceOwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalGatewayID != nil {
+		in, out := &in.LocalGatewayID, &out.LocalGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGatewayID != nil {
+		in, out := &in.NATGatewayID, &out.NATGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route_SDK.
+func (in *Route_SDK) DeepCopy() *Route_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Route_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleGroupRuleOptionsPair) DeepCopyInto(out *RuleGroupRuleOptionsPair) {
 	*out = *in
@@ -24495,6 +27396,182 @@ func (in *SecurityGroup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupEgress) DeepCopyInto(out *SecurityGroupEgress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgress.
+func (in *SecurityGroupEgress) DeepCopy() *SecurityGroupEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupEgress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupEgressList) DeepCopyInto(out *SecurityGroupEgressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupEgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgressList.
+func (in *SecurityGroupEgressList) DeepCopy() *SecurityGroupEgressList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupEgressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupEgressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupEgressSpec) DeepCopyInto(out *SecurityGroupEgressSpec) {
+	*out = *in
+	if in.CIDRIPv4 != nil {
+		in, out := &in.CIDRIPv4, &out.CIDRIPv4
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRIPv6 != nil {
+		in, out := &in.CIDRIPv6, &out.CIDRIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPProtocol != nil {
+		in, out := &in.IPProtocol, &out.IPProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedGroupID != nil {
+		in, out := &in.ReferencedGroupID, &out.ReferencedGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedGroupRef != nil {
+		in, out := &in.ReferencedGroupRef, &out.ReferencedGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgressSpec.
+func (in *SecurityGroupEgressSpec) DeepCopy() *SecurityGroupEgressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupEgressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupEgressStatus) DeepCopyInto(out *SecurityGroupEgressStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SecurityGroupRuleID != nil {
+		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgressStatus.
+func (in *SecurityGroupEgressStatus) DeepCopy() *SecurityGroupEgressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupEgressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupForVPC) DeepCopyInto(out *SecurityGroupForVPC) {
 	*out = *in
@@ -24571,6 +27648,182 @@ func (in *SecurityGroupIdentifier) DeepCopy() *SecurityGroupIdentifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIngress) DeepCopyInto(out *SecurityGroupIngress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIngress.
+func (in *SecurityGroupIngress) DeepCopy() *SecurityGroupIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupIngress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIngressList) DeepCopyInto(out *SecurityGroupIngressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupIngress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIngressList.
+func (in *SecurityGroupIngressList) DeepCopy() *SecurityGroupIngressList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIngressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupIngressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIngressSpec) DeepCopyInto(out *SecurityGroupIngressSpec) {
+	*out = *in
+	if in.CIDRIPv4 != nil {
+		in, out := &in.CIDRIPv4, &out.CIDRIPv4
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRIPv6 != nil {
+		in, out := &in.CIDRIPv6, &out.CIDRIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupRef != nil {
+		in, out := &in.GroupRef, &out.GroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPProtocol != nil {
+		in, out := &in.IPProtocol, &out.IPProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedGroupID != nil {
+		in, out := &in.ReferencedGroupID, &out.ReferencedGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedGroupRef != nil {
+		in, out := &in.ReferencedGroupRef, &out.ReferencedGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIngressSpec.
+func (in *SecurityGroupIngressSpec) DeepCopy() *SecurityGroupIngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIngressStatus) DeepCopyInto(out *SecurityGroupIngressStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SecurityGroupRuleID != nil {
+		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIngressStatus.
+func (in *SecurityGroupIngressStatus) DeepCopy() *SecurityGroupIngressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIngressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
 	*out = *in
@@ -26913,16 +30166,46 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolRef != nil {
+		in, out := &in.IPv4IPAMPoolRef, &out.IPv4IPAMPoolRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
 	if in.IPv6CIDRBlock != nil {
 		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6IPAMPoolID != nil {
+		in, out := &in.IPv6IPAMPoolID, &out.IPv6IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6IPAMPoolRef != nil {
+		in, out := &in.IPv6IPAMPoolRef, &out.IPv6IPAMPoolRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPv6Native != nil {
 		in, out := &in.IPv6Native, &out.IPv6Native
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IPv6NetmaskLength != nil {
+		in, out := &in.IPv6NetmaskLength, &out.IPv6NetmaskLength
+		*out = new(int64)
+		**out = **in
+	}
 	if in.MapPublicIPOnLaunch != nil {
 		in, out := &in.MapPublicIPOnLaunch, &out.MapPublicIPOnLaunch
 		*out = new(bool)
@@ -27007,6 +30290,11 @@ func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 			}
 		}
 	}
+	if in.AllocatedCIDRBlock != nil {
+		in, out := &in.AllocatedCIDRBlock, &out.AllocatedCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
 	if in.AvailableIPAddressCount != nil {
 		in, out := &in.AvailableIPAddressCount, &out.AvailableIPAddressCount
 		*out = new(int64)
@@ -28769,6 +32057,226 @@ func (in *TransitGatewayOptions) DeepCopy() *TransitGatewayOptions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeringAttachment) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment.
+func (in *TransitGatewayPeeringAttachment) DeepCopy() *TransitGatewayPeeringAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyInto(out *TransitGatewayPeeringAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayPeeringAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentList.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopy() *TransitGatewayPeeringAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentOptions) DeepCopyInto(out *TransitGatewayPeeringAttachmentOptions) {
+	*out = *in
+	if in.DynamicRouting != nil {
+		in, out := &in.DynamicRouting, &out.DynamicRouting
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentOptions.
+func (in *TransitGatewayPeeringAttachmentOptions) DeepCopy() *TransitGatewayPeeringAttachmentOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopyInto(out *TransitGatewayPeeringAttachmentSpec) {
+	*out = *in
+	if in.AcceptRequest != nil {
+		in, out := &in.AcceptRequest, &out.AcceptRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(CreateTransitGatewayPeeringAttachmentRequestOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerAccountID != nil {
+		in, out := &in.PeerAccountID, &out.PeerAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerRegion != nil {
+		in, out := &in.PeerRegion, &out.PeerRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerTransitGatewayID != nil {
+		in, out := &in.PeerTransitGatewayID, &out.PeerTransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerTransitGatewayRef != nil {
+		in, out := &in.PeerTransitGatewayRef, &out.PeerTransitGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRef != nil {
+		in, out := &in.TransitGatewayRef, &out.TransitGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentSpec.
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopy() *TransitGatewayPeeringAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopyInto(out *TransitGatewayPeeringAttachmentStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AccepterTgwInfo != nil {
+		in, out := &in.AccepterTgwInfo, &out.AccepterTgwInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccepterTransitGatewayAttachmentID != nil {
+		in, out := &in.AccepterTransitGatewayAttachmentID, &out.AccepterTransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestAccepted != nil {
+		in, out := &in.RequestAccepted, &out.RequestAccepted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequesterTgwInfo != nil {
+		in, out := &in.RequesterTgwInfo, &out.RequesterTgwInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(PeeringAttachmentStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentStatus.
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopy() *TransitGatewayPeeringAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachment_SDK) DeepCopyInto(out *TransitGatewayPeeringAttachment_SDK) {
+	*out = *in
+	if in.AccepterTgwInfo != nil {
+		in, out := &in.AccepterTgwInfo, &out.AccepterTgwInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AccepterTransitGatewayAttachmentID != nil {
 		in, out := &in.AccepterTransitGatewayAttachmentID, &out.AccepterTransitGatewayAttachmentID
 		*out = new(string)
@@ -28778,11 +32286,26 @@ func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeri
 		in, out := &in.CreationTime, &out.CreationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(TransitGatewayPeeringAttachmentOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequesterTgwInfo != nil {
+		in, out := &in.RequesterTgwInfo, &out.RequesterTgwInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.State != nil {
 		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(PeeringAttachmentStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -28801,12 +32324,12 @@ func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeri
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment.
-func (in *TransitGatewayPeeringAttachment) DeepCopy() *TransitGatewayPeeringAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment_SDK.
+func (in *TransitGatewayPeeringAttachment_SDK) DeepCopy() *TransitGatewayPeeringAttachment_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayPeeringAttachment)
+	out := new(TransitGatewayPeeringAttachment_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -29115,6 +32638,32 @@ func (in *TransitGatewayRoute) DeepCopyInto(out *TransitGatewayRoute) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachments != nil {
+		in, out := &in.TransitGatewayAttachments, &out.TransitGatewayAttachments
+		*out = make([]*TransitGatewayRouteAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TransitGatewayRouteAttachment)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayRouteTableAnnouncementID != nil {
+		in, out := &in.TransitGatewayRouteTableAnnouncementID, &out.TransitGatewayRouteTableAnnouncementID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRoute.
@@ -29135,6 +32684,11 @@ func (in *TransitGatewayRouteAttachment) DeepCopyInto(out *TransitGatewayRouteAt
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
 	if in.TransitGatewayAttachmentID != nil {
 		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
@@ -29155,41 +32709,10 @@ func (in *TransitGatewayRouteAttachment) DeepCopy() *TransitGatewayRouteAttachme
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTable) DeepCopyInto(out *TransitGatewayRouteTable) {
 	*out = *in
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.DefaultAssociationRouteTable != nil {
-		in, out := &in.DefaultAssociationRouteTable, &out.DefaultAssociationRouteTable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DefaultPropagationRouteTable != nil {
-		in, out := &in.DefaultPropagationRouteTable, &out.DefaultPropagationRouteTable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TransitGatewayID != nil {
-		in, out := &in.TransitGatewayID, &out.TransitGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayRouteTableID != nil {
-		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTable.
@@ -29202,6 +32725,14 @@ func (in *TransitGatewayRouteTable) DeepCopy() *TransitGatewayRouteTable {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTableAnnouncement) DeepCopyInto(out *TransitGatewayRouteTableAnnouncement) {
 	*out = *in
@@ -29282,6 +32813,38 @@ func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTa
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableList) DeepCopyInto(out *TransitGatewayRouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableList.
+func (in *TransitGatewayRouteTableList) DeepCopy() *TransitGatewayRouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTablePropagation) DeepCopyInto(out *TransitGatewayRouteTablePropagation) {
 	*out = *in
@@ -29357,6 +32920,213 @@ func (in *TransitGatewayRouteTableRoute) DeepCopy() *TransitGatewayRouteTableRou
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableSpec) DeepCopyInto(out *TransitGatewayRouteTableSpec) {
+	*out = *in
+	if in.AssociationRefs != nil {
+		in, out := &in.AssociationRefs, &out.AssociationRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.PropagationRefs != nil {
+		in, out := &in.PropagationRefs, &out.PropagationRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Propagations != nil {
+		in, out := &in.Propagations, &out.Propagations
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*CreateTransitGatewayRouteInput, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CreateTransitGatewayRouteInput)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRef != nil {
+		in, out := &in.TransitGatewayRef, &out.TransitGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableSpec.
+func (in *TransitGatewayRouteTableSpec) DeepCopy() *TransitGatewayRouteTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableStatus) DeepCopyInto(out *TransitGatewayRouteTableStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DefaultAssociationRouteTable != nil {
+		in, out := &in.DefaultAssociationRouteTable, &out.DefaultAssociationRouteTable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultPropagationRouteTable != nil {
+		in, out := &in.DefaultPropagationRouteTable, &out.DefaultPropagationRouteTable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RouteStatuses != nil {
+		in, out := &in.RouteStatuses, &out.RouteStatuses
+		*out = make([]*TransitGatewayRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TransitGatewayRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableStatus.
+func (in *TransitGatewayRouteTableStatus) DeepCopy() *TransitGatewayRouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTable_SDK) DeepCopyInto(out *TransitGatewayRouteTable_SDK) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DefaultAssociationRouteTable != nil {
+		in, out := &in.DefaultAssociationRouteTable, &out.DefaultAssociationRouteTable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultPropagationRouteTable != nil {
+		in, out := &in.DefaultPropagationRouteTable, &out.DefaultPropagationRouteTable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTable_SDK.
+func (in *TransitGatewayRouteTable_SDK) DeepCopy() *TransitGatewayRouteTable_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTable_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
 	*out = *in
@@ -30204,6 +33974,11 @@ func (in *VGWTelemetry) DeepCopyInto(out *VGWTelemetry) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 	if in.StatusMessage != nil {
 		in, out := &in.StatusMessage, &out.StatusMessage
 		*out = new(string)
@@ -31909,6 +35684,11 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolRef != nil {
+		in, out := &in.IPv4IPAMPoolRef, &out.IPv4IPAMPoolRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPv4NetmaskLength != nil {
 		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
 		*out = new(int64)
@@ -31924,11 +35704,27 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6CIDRBlocks != nil {
+		in, out := &in.IPv6CIDRBlocks, &out.IPv6CIDRBlocks
+		*out = make([]*AssociateVPCCIDRBlockInput, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AssociateVPCCIDRBlockInput)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.IPv6IPAMPoolID != nil {
 		in, out := &in.IPv6IPAMPoolID, &out.IPv6IPAMPoolID
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6IPAMPoolRef != nil {
+		in, out := &in.IPv6IPAMPoolRef, &out.IPv6IPAMPoolRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPv6NetmaskLength != nil {
 		in, out := &in.IPv6NetmaskLength, &out.IPv6NetmaskLength
 		*out = new(int64)
@@ -32177,62 +35973,10 @@ func (in *VPNConcentrator) DeepCopy() *VPNConcentrator {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNConnection) DeepCopyInto(out *VPNConnection) {
 	*out = *in
-	if in.Category != nil {
-		in, out := &in.Category, &out.Category
-		*out = new(string)
-		**out = **in
-	}
-	if in.CoreNetworkARN != nil {
-		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CoreNetworkAttachmentARN != nil {
-		in, out := &in.CoreNetworkAttachmentARN, &out.CoreNetworkAttachmentARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CustomerGatewayID != nil {
-		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PreSharedKeyARN != nil {
-		in, out := &in.PreSharedKeyARN, &out.PreSharedKeyARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TransitGatewayID != nil {
-		in, out := &in.TransitGatewayID, &out.TransitGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPNConcentratorID != nil {
-		in, out := &in.VPNConcentratorID, &out.VPNConcentratorID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPNConnectionID != nil {
-		in, out := &in.VPNConnectionID, &out.VPNConnectionID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPNGatewayID != nil {
-		in, out := &in.VPNGatewayID, &out.VPNGatewayID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnection.
@@ -32245,6 +35989,14 @@ func (in *VPNConnection) DeepCopy() *VPNConnection {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNConnectionDeviceType) DeepCopyInto(out *VPNConnectionDeviceType) {
 	*out = *in
@@ -32280,6 +36032,38 @@ func (in *VPNConnectionDeviceType) DeepCopy() *VPNConnectionDeviceType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionList) DeepCopyInto(out *VPNConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPNConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionList.
+func (in *VPNConnectionList) DeepCopy() *VPNConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNConnectionOptions) DeepCopyInto(out *VPNConnectionOptions) {
 	*out = *in
@@ -32323,6 +36107,27 @@ func (in *VPNConnectionOptions) DeepCopyInto(out *VPNConnectionOptions) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TunnelBandwidth != nil {
+		in, out := &in.TunnelBandwidth, &out.TunnelBandwidth
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelInsideIPVersion != nil {
+		in, out := &in.TunnelInsideIPVersion, &out.TunnelInsideIPVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelOptions != nil {
+		in, out := &in.TunnelOptions, &out.TunnelOptions
+		*out = make([]*TunnelOption, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TunnelOption)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionOptions.
@@ -32378,6 +36183,27 @@ func (in *VPNConnectionOptionsSpecification) DeepCopyInto(out *VPNConnectionOpti
 		*out = new(string)
 		**out = **in
 	}
+	if in.TunnelBandwidth != nil {
+		in, out := &in.TunnelBandwidth, &out.TunnelBandwidth
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelInsideIPVersion != nil {
+		in, out := &in.TunnelInsideIPVersion, &out.TunnelInsideIPVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelOptions != nil {
+		in, out := &in.TunnelOptions, &out.TunnelOptions
+		*out = make([]*VPNTunnelOptionsSpecification, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPNTunnelOptionsSpecification)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionOptionsSpecification.
@@ -32390,8 +36216,485 @@ func (in *VPNConnectionOptionsSpecification) DeepCopy() *VPNConnectionOptionsSpe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionRoute) DeepCopyInto(out *VPNConnectionRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionRoute.
+func (in *VPNConnectionRoute) DeepCopy() *VPNConnectionRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnectionRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionRouteList) DeepCopyInto(out *VPNConnectionRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPNConnectionRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionRouteList.
+func (in *VPNConnectionRouteList) DeepCopy() *VPNConnectionRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnectionRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionRouteSpec) DeepCopyInto(out *VPNConnectionRouteSpec) {
+	*out = *in
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNConnectionID != nil {
+		in, out := &in.VPNConnectionID, &out.VPNConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNConnectionRef != nil {
+		in, out := &in.VPNConnectionRef, &out.VPNConnectionRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionRouteSpec.
+func (in *VPNConnectionRouteSpec) DeepCopy() *VPNConnectionRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionRouteStatus) DeepCopyInto(out *VPNConnectionRouteStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionRouteStatus.
+func (in *VPNConnectionRouteStatus) DeepCopy() *VPNConnectionRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionSpec) DeepCopyInto(out *VPNConnectionSpec) {
+	*out = *in
+	if in.CustomerGatewayID != nil {
+		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomerGatewayRef != nil {
+		in, out := &in.CustomerGatewayRef, &out.CustomerGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(VPNConnectionOptionsSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreSharedKeySecretRef != nil {
+		in, out := &in.PreSharedKeySecretRef, &out.PreSharedKeySecretRef
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.PreSharedKeyStorage != nil {
+		in, out := &in.PreSharedKeyStorage, &out.PreSharedKeyStorage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRef != nil {
+		in, out := &in.TransitGatewayRef, &out.TransitGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNGatewayRef != nil {
+		in, out := &in.VPNGatewayRef, &out.VPNGatewayRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionSpec.
+func (in *VPNConnectionSpec) DeepCopy() *VPNConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnectionStatus) DeepCopyInto(out *VPNConnectionStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.CoreNetworkARN != nil {
+		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CoreNetworkAttachmentARN != nil {
+		in, out := &in.CoreNetworkAttachmentARN, &out.CoreNetworkAttachmentARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayAssociationState != nil {
+		in, out := &in.GatewayAssociationState, &out.GatewayAssociationState
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreSharedKeyARN != nil {
+		in, out := &in.PreSharedKeyARN, &out.PreSharedKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*VPNStaticRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPNStaticRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelOptions != nil {
+		in, out := &in.TunnelOptions, &out.TunnelOptions
+		*out = make([]*TunnelOption, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TunnelOption)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VGWTelemetry != nil {
+		in, out := &in.VGWTelemetry, &out.VGWTelemetry
+		*out = make([]*VGWTelemetry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VGWTelemetry)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPNConnectionID != nil {
+		in, out := &in.VPNConnectionID, &out.VPNConnectionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionStatus.
+func (in *VPNConnectionStatus) DeepCopy() *VPNConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnection_SDK) DeepCopyInto(out *VPNConnection_SDK) {
+	*out = *in
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.CoreNetworkARN != nil {
+		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CoreNetworkAttachmentARN != nil {
+		in, out := &in.CoreNetworkAttachmentARN, &out.CoreNetworkAttachmentARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomerGatewayID != nil {
+		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayAssociationState != nil {
+		in, out := &in.GatewayAssociationState, &out.GatewayAssociationState
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(VPNConnectionOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreSharedKeyARN != nil {
+		in, out := &in.PreSharedKeyARN, &out.PreSharedKeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]*VPNStaticRoute, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPNStaticRoute)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.VGWTelemetry != nil {
+		in, out := &in.VGWTelemetry, &out.VGWTelemetry
+		*out = make([]*VGWTelemetry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VGWTelemetry)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPNConcentratorID != nil {
+		in, out := &in.VPNConcentratorID, &out.VPNConcentratorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNConnectionID != nil {
+		in, out := &in.VPNConnectionID, &out.VPNConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnection_SDK.
+func (in *VPNConnection_SDK) DeepCopy() *VPNConnection_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNConnection_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway.
+func (in *VPNGateway) DeepCopy() *VPNGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNGatewayList) DeepCopyInto(out *VPNGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPNGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayList.
+func (in *VPNGatewayList) DeepCopy() *VPNGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNGatewaySpec) DeepCopyInto(out *VPNGatewaySpec) {
 	*out = *in
 	if in.AmazonSideASN != nil {
 		in, out := &in.AmazonSideASN, &out.AmazonSideASN
@@ -32414,6 +36717,68 @@ func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
 			}
 		}
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewaySpec.
+func (in *VPNGatewaySpec) DeepCopy() *VPNGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNGatewayStatus) DeepCopyInto(out *VPNGatewayStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCAttachments != nil {
+		in, out := &in.VPCAttachments, &out.VPCAttachments
+		*out = make([]*VPCAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCAttachment)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.VPNGatewayID != nil {
 		in, out := &in.VPNGatewayID, &out.VPNGatewayID
 		*out = new(string)
@@ -32421,12 +36786,74 @@ func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway.
-func (in *VPNGateway) DeepCopy() *VPNGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayStatus.
+func (in *VPNGatewayStatus) DeepCopy() *VPNGatewayStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VPNGateway)
+	out := new(VPNGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNGateway_SDK) DeepCopyInto(out *VPNGateway_SDK) {
+	*out = *in
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCAttachments != nil {
+		in, out := &in.VPCAttachments, &out.VPCAttachments
+		*out = make([]*VPCAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCAttachment)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway_SDK.
+func (in *VPNGateway_SDK) DeepCopy() *VPNGateway_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNGateway_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -32439,6 +36866,16 @@ func (in *VPNStaticRoute) DeepCopyInto(out *VPNStaticRoute) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNStaticRoute.
@@ -33412,6 +37849,87 @@ func (in *VerifiedAccessTrustProviderCondensed) DeepCopy() *VerifiedAccessTrustP
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume) DeepCopyInto(out *Volume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
+func (in *Volume) DeepCopy() *Volume {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Volume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
+	*out = *in
+	if in.AssociatedResource != nil {
+		in, out := &in.AssociatedResource, &out.AssociatedResource
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Device != nil {
+		in, out := &in.Device, &out.Device
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceOwningService != nil {
+		in, out := &in.InstanceOwningService, &out.InstanceOwningService
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAttachment.
+func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeDetail) DeepCopyInto(out *VolumeDetail) {
 	*out = *in
@@ -33432,6 +37950,38 @@ func (in *VolumeDetail) DeepCopy() *VolumeDetail {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeList) DeepCopyInto(out *VolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeList.
+func (in *VolumeList) DeepCopy() *VolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeModification) DeepCopyInto(out *VolumeModification) {
 	*out = *in
@@ -33439,6 +37989,11 @@ func (in *VolumeModification) DeepCopyInto(out *VolumeModification) {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ModificationState != nil {
+		in, out := &in.ModificationState, &out.ModificationState
+		*out = new(string)
+		**out = **in
+	}
 	if in.OriginalIOPS != nil {
 		in, out := &in.OriginalIOPS, &out.OriginalIOPS
 		*out = new(int64)
@@ -33597,6 +38152,183 @@ func (in *VolumeRecycleBinInfo) DeepCopy() *VolumeRecycleBinInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
+	*out = *in
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Device != nil {
+		in, out := &in.Device, &out.Device
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MultiAttachEnabled != nil {
+		in, out := &in.MultiAttachEnabled, &out.MultiAttachEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSpec.
+func (in *VolumeSpec) DeepCopy() *VolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]*VolumeAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VolumeAttachment)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FastRestored != nil {
+		in, out := &in.FastRestored, &out.FastRestored
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Modification != nil {
+		in, out := &in.Modification, &out.Modification
+		*out = new(VolumeModification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceVolumeID != nil {
+		in, out := &in.SourceVolumeID, &out.SourceVolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEType != nil {
+		in, out := &in.SSEType, &out.SSEType
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeStatusAction) DeepCopyInto(out *VolumeStatusAction) {
 	*out = *in
@@ -33754,3 +38486,129 @@ func (in *VolumeStatusItem) DeepCopy() *VolumeStatusItem {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume_SDK) DeepCopyInto(out *Volume_SDK) {
+	*out = *in
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]*VolumeAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VolumeAttachment)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZoneID != nil {
+		in, out := &in.AvailabilityZoneID, &out.AvailabilityZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FastRestored != nil {
+		in, out := &in.FastRestored, &out.FastRestored
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MultiAttachEnabled != nil {
+		in, out := &in.MultiAttachEnabled, &out.MultiAttachEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(OperatorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceVolumeID != nil {
+		in, out := &in.SourceVolumeID, &out.SourceVolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEType != nil {
+		in, out := &in.SSEType, &out.SSEType
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume_SDK.
+func (in *Volume_SDK) DeepCopy() *Volume_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -45,25 +45,45 @@ import (
 	svcresource "github.com/aws-controllers-k8s/ec2-controller/pkg/resource"
 
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/capacity_reservation"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/carrier_gateway"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/customer_gateway"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/default_network_acl"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/default_route_table"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/default_security_group"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/dhcp_options"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/egress_only_internet_gateway"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/elastic_ip_address"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/flow_log"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/instance"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/internet_gateway"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/ipam"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/ipam_pool"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/ipam_scope"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/key_pair"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/launch_template"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/managed_prefix_list"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/nat_gateway"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/network_acl"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/network_interface"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/placement_group"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/route"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/route_table"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/security_group"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/security_group_egress"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/security_group_ingress"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/subnet"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/transit_gateway"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/transit_gateway_peering_attachment"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/transit_gateway_route_table"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/transit_gateway_vpc_attachment"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/volume"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpc"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpc_endpoint"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpc_endpoint_service_configuration"
 	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpc_peering_connection"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpn_connection"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpn_connection_route"
+	_ "github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpn_gateway"
 
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/version"
 )
@@ -81,25 +81,45 @@ rules:
   - ec2.services.k8s.aws
   resources:
   - capacityreservations
+  - carriergateways
+  - customergateways
+  - defaultnetworkacls
+  - defaultroutetables
+  - defaultsecuritygroups
   - dhcpoptions
   - egressonlyinternetgateways
   - elasticipaddresses
   - flowlogs
   - instances
   - internetgateways
+  - ipampools
+  - ipams
+  - ipamscopes
+  - keypairs
   - launchtemplates
   - managedprefixlists
   - natgateways
   - networkacls
+  - networkinterfaces
+  - placementgroups
+  - routes
   - routetables
+  - securitygroupegresses
+  - securitygroupingresses
   - securitygroups
   - subnets
   - transitgateways
+  - transitgatewaypeeringattachments
+  - transitgatewayroutetables
   - transitgatewayvpcattachments
+  - volumes
   - vpcendpoints
   - vpcendpointserviceconfigurations
   - vpcpeeringconnections
   - vpcs
+  - vpnconnectionroutes
+  - vpnconnections
+  - vpngateways
   verbs:
   - create
   - delete
@@ -112,25 +132,45 @@ rules:
   - ec2.services.k8s.aws
   resources:
   - capacityreservations/status
+  - carriergateways/status
+  - customergateways/status
+  - defaultnetworkacls/status
+  - defaultroutetables/status
+  - defaultsecuritygroups/status
   - dhcpoptions/status
   - egressonlyinternetgateways/status
   - elasticipaddresses/status
   - flowlogs/status
   - instances/status
   - internetgateways/status
+  - ipampools/status
+  - ipams/status
+  - ipamscopes/status
+  - keypairs/status
   - launchtemplates/status
   - managedprefixlists/status
   - natgateways/status
   - networkacls/status
+  - networkinterfaces/status
+  - placementgroups/status
+  - routes/status
   - routetables/status
+  - securitygroupegresses/status
+  - securitygroupingresses/status
   - securitygroups/status
   - subnets/status
   - transitgateways/status
+  - transitgatewaypeeringattachments/status
+  - transitgatewayroutetables/status
   - transitgatewayvpcattachments/status
+  - volumes/status
   - vpcendpoints/status
   - vpcendpointserviceconfigurations/status
   - vpcpeeringconnections/status
   - vpcs/status
+  - vpnconnectionroutes/status
+  - vpnconnections/status
+  - vpngateways/status
   verbs:
   - get
   - patch
@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package carrier_gateway
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+// isResourceDeleted returns true once the carrier gateway is deleted.
+// DescribeCarrierGateways keeps returning deleted carrier gateways for a
+// while.
+func isResourceDeleted(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	status := *r.ko.Status.State
+	return status == string(svcsdktypes.CarrierGatewayStateDeleted)
+}
+
+func (rm *resourceManager) customUpdateCarrierGateway(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateCarrierGateway")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+	updated.ko.Status = latest.ko.Status
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.CarrierGatewayID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateCarrierGatewayInput.TagSpecification
+// and ensures the ResourceType is always set to 'carrier-gateway'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateCarrierGatewayInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "carrier-gateway"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,32 @@
+package carrier_gateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+)
+
+func TestIsResourceDeleted(t *testing.T) {
+	tt := []struct {
+		state   *string
+		deleted bool
+	}{
+		{nil, false},
+		{aws.String("pending"), false},
+		{aws.String("available"), false},
+		{aws.String("deleting"), false},
+		{aws.String("deleted"), true},
+	}
+
+	for _, tc := range tt {
+		t.Run(aws.StringValue(tc.state), func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.CarrierGateway{
+				Status: svcapitypes.CarrierGatewayStatus{State: tc.state},
+			}}
+			assert.Equal(t, tc.deleted, isResourceDeleted(r))
+		})
+	}
+}
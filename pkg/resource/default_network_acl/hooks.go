@@ -0,0 +1,288 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package default_network_acl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/network_acl"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/vpcdefaults"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var (
+	ErrDefaultNetworkACLNotFound = fmt.Errorf(
+		"default network ACL of the VPC not found",
+	)
+	requeueWaitForDefaultNetworkACL = ackrequeue.NeededAfter(
+		ErrDefaultNetworkACLNotFound,
+		5*time.Second,
+	)
+)
+
+// networkACLDescriptor describes the NetworkACL resources the default
+// network ACL is managed as.
+var networkACLDescriptor = network_acl.NewResourceDescriptor()
+
+// networkACLManager returns a NetworkACL resource manager for the account and
+// region of rm. Entries and tags of the default network ACL are synced by it
+// exactly like those of any other network ACL.
+func (rm *resourceManager) networkACLManager() (acktypes.AWSResourceManager, error) {
+	return network_acl.NewResourceManager(
+		rm.cfg, rm.clientcfg, rm.log, rm.metrics, rm.rr,
+		rm.awsAccountID, rm.awsRegion,
+	)
+}
+
+// newNetworkACL returns a NetworkACL resource with a copy of the metadata,
+// Spec and Status of the supplied DefaultNetworkACL. The subnet associations
+// of the default network ACL are observed only, and are set in the Spec of the
+// NetworkACL so that they are never changed.
+func newNetworkACL(ko *svcapitypes.DefaultNetworkACL) acktypes.AWSResource {
+	ko = ko.DeepCopy()
+	return networkACLDescriptor.ResourceFromRuntimeObject(&svcapitypes.NetworkACL{
+		ObjectMeta: ko.ObjectMeta,
+		Spec: svcapitypes.NetworkACLSpec{
+			Associations: ko.Status.Associations,
+			Entries:      ko.Spec.Entries,
+			Tags:         ko.Spec.Tags,
+			VPCID:        ko.Spec.VPCID,
+			VPCRef:       ko.Spec.VPCRef,
+		},
+		Status: svcapitypes.NetworkACLStatus{
+			ACKResourceMetadata: ko.Status.ACKResourceMetadata,
+			Conditions:          ko.Status.Conditions,
+			ID:                  ko.Status.ID,
+			OwnerID:             ko.Status.OwnerID,
+		},
+	})
+}
+
+// setFromNetworkACL sets the Spec and Status of ko from the supplied
+// NetworkACL resource.
+func setFromNetworkACL(ko *svcapitypes.DefaultNetworkACL, res acktypes.AWSResource) {
+	nacl := res.RuntimeObject().(*svcapitypes.NetworkACL).DeepCopy()
+	ko.Spec.Entries = nacl.Spec.Entries
+	ko.Spec.Tags = nacl.Spec.Tags
+	ko.Spec.VPCID = nacl.Spec.VPCID
+	ko.Spec.VPCRef = nacl.Spec.VPCRef
+	ko.Status.ACKResourceMetadata = nacl.Status.ACKResourceMetadata
+	ko.Status.Associations = nacl.Spec.Associations
+	ko.Status.Conditions = nacl.Status.Conditions
+	ko.Status.ID = nacl.Status.ID
+	ko.Status.OwnerID = nacl.Status.OwnerID
+}
+
+// customFindDefaultNetworkACL reads the default network ACL through the
+// NetworkACL resource manager. The network ACL is not known until it has been
+// adopted by sdkCreate.
+func (rm *resourceManager) customFindDefaultNetworkACL(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindDefaultNetworkACL")
+	defer func() {
+		exit(err)
+	}()
+
+	observed, err := vpcdefaults.Find(ctx, r.ko.Status.ID, rm.networkACLManager, newNetworkACL(r.ko))
+	if observed == nil {
+		return nil, err
+	}
+
+	ko := r.ko.DeepCopy()
+	setFromNetworkACL(ko, observed)
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, err
+}
+
+// customCreateDefaultNetworkACL adopts the default network ACL of the VPC,
+// which is created along with the VPC, and updates it to match the desired
+// state.
+func (rm *resourceManager) customCreateDefaultNetworkACL(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customCreateDefaultNetworkACL")
+	defer func() {
+		exit(err)
+	}()
+
+	id, err := rm.getDefaultNetworkACLID(ctx, desired.ko.Spec.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	if id == nil {
+		return nil, requeueWaitForDefaultNetworkACL
+	}
+
+	ko := desired.ko.DeepCopy()
+	ko.Status.ID = id
+	rm.setStatusDefaults(ko)
+	return vpcdefaults.Adopt(ctx, &resource{ko}, rm.sdkFind, newResourceDelta, rm.sdkUpdate)
+}
+
+// customUpdateDefaultNetworkACL updates the default network ACL through the
+// NetworkACL resource manager.
+func (rm *resourceManager) customUpdateDefaultNetworkACL(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateDefaultNetworkACL")
+	defer func() {
+		exit(err)
+	}()
+
+	mgr, err := rm.networkACLManager()
+	if err != nil {
+		return nil, err
+	}
+	// Subnet associations are not managed, keep the observed ones.
+	ko := desired.ko.DeepCopy()
+	ko.Status.Associations = latest.ko.Status.Associations
+	// The NetworkACL delta drops the default rule from the resources it
+	// compares, so it is computed on copies.
+	naclDelta := networkACLDescriptor.Delta(newNetworkACL(ko), newNetworkACL(latest.ko))
+	res, err := mgr.Update(ctx, newNetworkACL(ko), newNetworkACL(latest.ko), naclDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	updated = rm.concreteResource(desired.DeepCopy())
+	setFromNetworkACL(updated.ko, res)
+	// The order of the desired entries is kept, see customUpdateNetworkAcl.
+	updated.ko.Spec.Entries = desired.ko.Spec.Entries
+	return updated, nil
+}
+
+// customDeleteDefaultNetworkACL resets the default network ACL to the state it
+// had when the VPC was created: entries allowing all inbound and outbound
+// traffic and none of the tags set through the resource. The network ACL
+// itself is deleted along with the VPC.
+func (rm *resourceManager) customDeleteDefaultNetworkACL(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteDefaultNetworkACL")
+	defer func() {
+		exit(err)
+	}()
+
+	latest, err = rm.sdkFind(ctx, r)
+	if err == ackerr.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ipv6, err := vpcdefaults.HasIPv6CIDRBlock(ctx, rm.sdkapi, rm.metrics, latest.ko.Spec.VPCID)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := latest.ko.DeepCopy()
+	ko.Spec.Entries = defaultEntries(ipv6)
+	ko.Spec.Tags = vpcdefaults.RemoveTags(latest.ko.Spec.Tags, r.ko.Spec.Tags)
+	delta := newResourceDelta(&resource{ko}, latest)
+	if len(delta.Differences) == 0 {
+		return nil, nil
+	}
+	_, err = rm.sdkUpdate(ctx, &resource{ko}, latest, delta)
+	return nil, err
+}
+
+// defaultEntries returns the entries EC2 creates in the default network ACL
+// of a VPC, which allow all inbound and outbound IPv4 traffic, and IPv6
+// traffic if the VPC has an IPv6 CIDR block. The rule denying all traffic
+// that is not matched by another rule is not part of the returned entries.
+func defaultEntries(ipv6 bool) []*svcapitypes.NetworkACLEntry {
+	entries := []*svcapitypes.NetworkACLEntry{}
+	for _, egress := range []bool{false, true} {
+		entries = append(entries, &svcapitypes.NetworkACLEntry{
+			CIDRBlock:  aws.String("0.0.0.0/0"),
+			Egress:     aws.Bool(egress),
+			Protocol:   aws.String("-1"),
+			RuleAction: aws.String("allow"),
+			RuleNumber: aws.Int64(100),
+		})
+		if ipv6 {
+			entries = append(entries, &svcapitypes.NetworkACLEntry{
+				Egress:        aws.Bool(egress),
+				IPv6CIDRBlock: aws.String("::/0"),
+				Protocol:      aws.String("-1"),
+				RuleAction:    aws.String("allow"),
+				RuleNumber:    aws.Int64(101),
+			})
+		}
+	}
+	return entries
+}
+
+// getDefaultNetworkACLID returns the ID of the default network ACL of the VPC,
+// or nil if there is none.
+func (rm *resourceManager) getDefaultNetworkACLID(
+	ctx context.Context,
+	vpcID *string,
+) (*string, error) {
+	input := &svcsdk.DescribeNetworkAclsInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{*vpcID},
+			},
+			{
+				Name:   aws.String("default"),
+				Values: []string{"true"},
+			},
+		},
+	}
+	resp, err := rm.sdkapi.DescribeNetworkAcls(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeNetworkAcls", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.NetworkAcls) == 0 {
+		return nil, nil
+	}
+	return resp.NetworkAcls[0].NetworkAclId, nil
+}
+
+// customPreCompare compares Spec.Entries the same way they are compared for
+// NetworkACL resources.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	naclDelta := networkACLDescriptor.Delta(newNetworkACL(a.ko), newNetworkACL(b.ko))
+	if naclDelta.DifferentAt("Spec.Entries") {
+		delta.Add("Spec.Entries", a.ko.Spec.Entries, b.ko.Spec.Entries)
+	}
+}
@@ -0,0 +1,87 @@
+package default_network_acl
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkACLConversion(t *testing.T) {
+	ko := &svcapitypes.DefaultNetworkACL{
+		Spec: svcapitypes.DefaultNetworkACLSpec{
+			Entries: defaultEntries(false),
+			VPCID:   aws.String("vpc-1"),
+		},
+		Status: svcapitypes.DefaultNetworkACLStatus{
+			Associations: []*svcapitypes.NetworkACLAssociation{{
+				NetworkACLAssociationID: aws.String("aclassoc-1"),
+				SubnetID:                aws.String("subnet-1"),
+			}},
+			ID: aws.String("acl-1"),
+		},
+	}
+
+	res := newNetworkACL(ko)
+	nacl := res.RuntimeObject().(*svcapitypes.NetworkACL)
+	assert.Equal(t, "acl-1", *nacl.Status.ID)
+	assert.Equal(t, "subnet-1", *nacl.Spec.Associations[0].SubnetID)
+	assert.Len(t, nacl.Spec.Entries, 2)
+
+	got := &svcapitypes.DefaultNetworkACL{}
+	setFromNetworkACL(got, res)
+	assert.Equal(t, ko.Spec, got.Spec)
+	assert.Equal(t, ko.Status.Associations, got.Status.Associations)
+	assert.Equal(t, "acl-1", *got.Status.ID)
+}
+
+func TestDefaultEntries(t *testing.T) {
+	entries := defaultEntries(false)
+	assert.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, "0.0.0.0/0", *e.CIDRBlock)
+		assert.Equal(t, "allow", *e.RuleAction)
+		assert.Equal(t, int64(100), *e.RuleNumber)
+	}
+	assert.NotEqual(t, *entries[0].Egress, *entries[1].Egress)
+
+	entries = defaultEntries(true)
+	assert.Len(t, entries, 4)
+	ipv6 := 0
+	for _, e := range entries {
+		if e.IPv6CIDRBlock != nil {
+			assert.Equal(t, "::/0", *e.IPv6CIDRBlock)
+			assert.Equal(t, int64(101), *e.RuleNumber)
+			ipv6++
+		}
+	}
+	assert.Equal(t, 2, ipv6)
+}
+
+func TestCustomPreCompare(t *testing.T) {
+	denyAll := &svcapitypes.NetworkACLEntry{
+		CIDRBlock:  aws.String("0.0.0.0/0"),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("-1"),
+		RuleAction: aws.String("deny"),
+		RuleNumber: aws.Int64(32767),
+	}
+	a := &resource{ko: &svcapitypes.DefaultNetworkACL{
+		Spec: svcapitypes.DefaultNetworkACLSpec{Entries: defaultEntries(false)},
+	}}
+	b := &resource{ko: &svcapitypes.DefaultNetworkACL{
+		Spec: svcapitypes.DefaultNetworkACLSpec{Entries: append(defaultEntries(false), denyAll)},
+	}}
+
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, a, b)
+	assert.Empty(t, delta.Differences)
+	assert.Len(t, b.ko.Spec.Entries, 3)
+
+	a.ko.Spec.Entries = a.ko.Spec.Entries[:1]
+	delta = ackcompare.NewDelta()
+	customPreCompare(delta, a, b)
+	assert.True(t, delta.DifferentAt("Spec.Entries"))
+}
@@ -0,0 +1,314 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package default_route_table
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/route_table"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/vpcdefaults"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var (
+	ErrMainRouteTableNotFound = fmt.Errorf(
+		"main route table of the VPC not found",
+	)
+	requeueWaitForMainRouteTable = ackrequeue.NeededAfter(
+		ErrMainRouteTableNotFound,
+		5*time.Second,
+	)
+)
+
+// routeTableDescriptor describes the RouteTable resources the main route
+// table is managed as.
+var routeTableDescriptor = route_table.NewResourceDescriptor()
+
+// routeTableManager returns a RouteTable resource manager for the account and
+// region of rm. Routes, route propagations and tags of the main route table
+// are synced by it exactly like those of any other route table.
+func (rm *resourceManager) routeTableManager() (acktypes.AWSResourceManager, error) {
+	return route_table.NewResourceManager(
+		rm.cfg, rm.clientcfg, rm.log, rm.metrics, rm.rr,
+		rm.awsAccountID, rm.awsRegion,
+	)
+}
+
+// newRouteTable returns a RouteTable resource with a copy of the metadata,
+// Spec and Status of the supplied DefaultRouteTable. Spec.Main is left unset,
+// so that the main route table association is never changed and
+// Status.OriginalMainRouteTableID is never set.
+func newRouteTable(ko *svcapitypes.DefaultRouteTable) acktypes.AWSResource {
+	ko = ko.DeepCopy()
+	return routeTableDescriptor.ResourceFromRuntimeObject(&svcapitypes.RouteTable{
+		ObjectMeta: ko.ObjectMeta,
+		Spec: svcapitypes.RouteTableSpec{
+			PropagatingVPNGateways:    ko.Spec.PropagatingVPNGateways,
+			PropagatingVPNGatewayRefs: ko.Spec.PropagatingVPNGatewayRefs,
+			Routes:                    ko.Spec.Routes,
+			Tags:                      ko.Spec.Tags,
+			VPCID:                     ko.Spec.VPCID,
+			VPCRef:                    ko.Spec.VPCRef,
+		},
+		Status: svcapitypes.RouteTableStatus{
+			ACKResourceMetadata: ko.Status.ACKResourceMetadata,
+			Conditions:          ko.Status.Conditions,
+			Associations:        ko.Status.Associations,
+			OwnerID:             ko.Status.OwnerID,
+			PropagatingVGWs:     ko.Status.PropagatingVGWs,
+			RouteFailures:       ko.Status.RouteFailures,
+			RouteOwners:         ko.Status.RouteOwners,
+			RouteStatuses:       ko.Status.RouteStatuses,
+			RouteTableID:        ko.Status.RouteTableID,
+			UnmanagedRoutes:     ko.Status.UnmanagedRoutes,
+		},
+	})
+}
+
+// setFromRouteTable sets the Spec and Status of ko from the supplied
+// RouteTable resource.
+func setFromRouteTable(ko *svcapitypes.DefaultRouteTable, res acktypes.AWSResource) {
+	rt := res.RuntimeObject().(*svcapitypes.RouteTable).DeepCopy()
+	ko.Spec.PropagatingVPNGateways = rt.Spec.PropagatingVPNGateways
+	ko.Spec.PropagatingVPNGatewayRefs = rt.Spec.PropagatingVPNGatewayRefs
+	ko.Spec.Routes = rt.Spec.Routes
+	ko.Spec.Tags = rt.Spec.Tags
+	ko.Spec.VPCID = rt.Spec.VPCID
+	ko.Spec.VPCRef = rt.Spec.VPCRef
+	setStatusFromRouteTable(ko, res)
+}
+
+// setStatusFromRouteTable sets the Status of ko from the supplied RouteTable
+// resource.
+func setStatusFromRouteTable(ko *svcapitypes.DefaultRouteTable, res acktypes.AWSResource) {
+	rt := res.RuntimeObject().(*svcapitypes.RouteTable).DeepCopy()
+	ko.Status.ACKResourceMetadata = rt.Status.ACKResourceMetadata
+	ko.Status.Conditions = rt.Status.Conditions
+	ko.Status.Associations = rt.Status.Associations
+	ko.Status.OwnerID = rt.Status.OwnerID
+	ko.Status.PropagatingVGWs = rt.Status.PropagatingVGWs
+	ko.Status.RouteFailures = rt.Status.RouteFailures
+	ko.Status.RouteOwners = rt.Status.RouteOwners
+	ko.Status.RouteStatuses = rt.Status.RouteStatuses
+	ko.Status.RouteTableID = rt.Status.RouteTableID
+	ko.Status.UnmanagedRoutes = rt.Status.UnmanagedRoutes
+}
+
+// customFindDefaultRouteTable reads the main route table through the
+// RouteTable resource manager. The route table adopted by sdkCreate is pinned
+// in Status.RouteTableID; until then, it is not found. Once another route
+// table is made main, for example by a RouteTable resource with Spec.Main, the
+// adopted route table is no longer managed and a terminal error is reported.
+func (rm *resourceManager) customFindDefaultRouteTable(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindDefaultRouteTable")
+	defer func() {
+		exit(err)
+	}()
+
+	observed, err := vpcdefaults.Find(ctx, r.ko.Status.RouteTableID, rm.routeTableManager, newRouteTable(r.ko))
+	if observed == nil {
+		return nil, err
+	}
+
+	ko := r.ko.DeepCopy()
+	setFromRouteTable(ko, observed)
+	rm.setStatusDefaults(ko)
+	if err == nil && !isMainRouteTable(ko) {
+		err = newMainRouteTableChangedError(ko)
+	}
+	return &resource{ko}, err
+}
+
+// isMainRouteTable returns true if the route table is still the main route
+// table of its VPC.
+func isMainRouteTable(ko *svcapitypes.DefaultRouteTable) bool {
+	for _, assoc := range ko.Status.Associations {
+		if aws.ToBool(assoc.Main) {
+			return true
+		}
+	}
+	return false
+}
+
+// newMainRouteTableChangedError returns the terminal error reported once the
+// adopted route table is no longer the main route table of the VPC.
+func newMainRouteTableChangedError(ko *svcapitypes.DefaultRouteTable) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"route table %s is no longer the main route table of VPC %s and is "+
+			"not managed anymore; make it main again or delete the resource",
+		aws.ToString(ko.Status.RouteTableID), aws.ToString(ko.Spec.VPCID),
+	))
+}
+
+// customCreateDefaultRouteTable adopts the main route table of the VPC, which
+// is created along with the VPC, and updates it to match the desired state.
+func (rm *resourceManager) customCreateDefaultRouteTable(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customCreateDefaultRouteTable")
+	defer func() {
+		exit(err)
+	}()
+
+	id, err := rm.getMainRouteTableID(ctx, desired.ko.Spec.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	if id == nil {
+		return nil, requeueWaitForMainRouteTable
+	}
+
+	ko := desired.ko.DeepCopy()
+	ko.Status.RouteTableID = id
+	rm.setStatusDefaults(ko)
+	return vpcdefaults.Adopt(ctx, &resource{ko}, rm.sdkFind, newResourceDelta, rm.sdkUpdate)
+}
+
+// customUpdateDefaultRouteTable updates the main route table through the
+// RouteTable resource manager.
+func (rm *resourceManager) customUpdateDefaultRouteTable(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateDefaultRouteTable")
+	defer func() {
+		exit(err)
+	}()
+
+	mgr, err := rm.routeTableManager()
+	if err != nil {
+		return nil, err
+	}
+	desiredKo := desired.ko.DeepCopy()
+	// The RouteTable delta drops the local and propagated routes from the
+	// resources it compares, so it is computed on copies.
+	rtDelta := routeTableDescriptor.Delta(newRouteTable(desiredKo), newRouteTable(latest.ko))
+	res, err := mgr.Update(ctx, newRouteTable(desiredKo), newRouteTable(latest.ko), rtDelta)
+	if err != nil {
+		if res == nil {
+			return nil, err
+		}
+		// The resource is returned along with the error, so that
+		// Status.RouteFailures is saved.
+		updated = &resource{desiredKo}
+		setStatusFromRouteTable(updated.ko, res)
+		return updated, err
+	}
+
+	updated = &resource{desiredKo}
+	setFromRouteTable(updated.ko, res)
+	return updated, nil
+}
+
+// customDeleteDefaultRouteTable resets the main route table to the state it
+// had when the VPC was created: only the local route, no route propagation
+// and none of the tags set through the resource. The route table itself is
+// deleted along with the VPC.
+func (rm *resourceManager) customDeleteDefaultRouteTable(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteDefaultRouteTable")
+	defer func() {
+		exit(err)
+	}()
+
+	latest, err = rm.sdkFind(ctx, r)
+	if err == ackerr.NotFound {
+		return nil, nil
+	}
+	// A route table that is no longer main is left as it is.
+	if latest != nil && !isMainRouteTable(latest.ko) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ko := latest.ko.DeepCopy()
+	ko.Spec.Routes = nil
+	ko.Spec.PropagatingVPNGateways = nil
+	ko.Spec.Tags = vpcdefaults.RemoveTags(latest.ko.Spec.Tags, r.ko.Spec.Tags)
+	delta := newResourceDelta(&resource{ko}, latest)
+	if len(delta.Differences) == 0 {
+		return nil, nil
+	}
+	_, err = rm.sdkUpdate(ctx, &resource{ko}, latest, delta)
+	return nil, err
+}
+
+// getMainRouteTableID returns the ID of the main route table of the VPC, or
+// nil if there is none.
+func (rm *resourceManager) getMainRouteTableID(
+	ctx context.Context,
+	vpcID *string,
+) (*string, error) {
+	input := &svcsdk.DescribeRouteTablesInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{*vpcID},
+			},
+			{
+				Name:   aws.String("association.main"),
+				Values: []string{"true"},
+			},
+		},
+	}
+	resp, err := rm.sdkapi.DescribeRouteTables(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeRouteTables", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RouteTables) == 0 {
+		return nil, nil
+	}
+	return resp.RouteTables[0].RouteTableId, nil
+}
+
+// customPreCompare compares Spec.Routes and Spec.PropagatingVPNGateways the
+// same way they are compared for RouteTable resources.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	rtDelta := routeTableDescriptor.Delta(newRouteTable(a.ko), newRouteTable(b.ko))
+	if rtDelta.DifferentAt("Spec.PropagatingVPNGateways") {
+		delta.Add("Spec.PropagatingVPNGateways", a.ko.Spec.PropagatingVPNGateways, b.ko.Spec.PropagatingVPNGateways)
+	}
+	if rtDelta.DifferentAt("Spec.Routes") {
+		delta.Add("Spec.Routes", a.ko.Spec.Routes, b.ko.Spec.Routes)
+	}
+}
@@ -0,0 +1,116 @@
+package default_route_table
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRouteTableConversion(t *testing.T) {
+	ko := &svcapitypes.DefaultRouteTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+		Spec: svcapitypes.DefaultRouteTableSpec{
+			Routes: []*svcapitypes.CreateRouteInput{{
+				DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+				GatewayID:            aws.String("igw-1"),
+			}},
+			VPCID: aws.String("vpc-1"),
+		},
+		Status: svcapitypes.DefaultRouteTableStatus{
+			RouteFailures: map[string]*string{"10.1.0.0/16": aws.String("failed")},
+			RouteOwners:   map[string]*string{"10.2.0.0/16": aws.String("default/route")},
+			RouteTableID:  aws.String("rtb-1"),
+			UnmanagedRoutes: []*svcapitypes.Route_SDK{{
+				DestinationCIDRBlock: aws.String("10.3.0.0/16"),
+			}},
+		},
+	}
+
+	res := newRouteTable(ko)
+	rt := res.RuntimeObject().(*svcapitypes.RouteTable)
+	assert.Equal(t, "main", rt.Name)
+	assert.Equal(t, "rtb-1", *rt.Status.RouteTableID)
+	assert.Equal(t, "igw-1", *rt.Spec.Routes[0].GatewayID)
+
+	// The RouteTable is a copy
+	rt.Spec.Routes[0].GatewayID = aws.String("igw-2")
+	assert.Equal(t, "igw-1", *ko.Spec.Routes[0].GatewayID)
+
+	got := &svcapitypes.DefaultRouteTable{}
+	setFromRouteTable(got, res)
+	assert.Equal(t, "igw-2", *got.Spec.Routes[0].GatewayID)
+	assert.Equal(t, "vpc-1", *got.Spec.VPCID)
+	assert.Equal(t, "rtb-1", *got.Status.RouteTableID)
+	assert.Equal(t, ko.Status.RouteFailures, got.Status.RouteFailures)
+	assert.Equal(t, ko.Status.RouteOwners, got.Status.RouteOwners)
+	assert.Equal(t, ko.Status.UnmanagedRoutes, got.Status.UnmanagedRoutes)
+}
+
+func TestCustomPreCompare(t *testing.T) {
+	route := func(gatewayID string, cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			GatewayID:            aws.String(gatewayID),
+		}
+	}
+	newResource := func(routes ...*svcapitypes.CreateRouteInput) *resource {
+		return &resource{ko: &svcapitypes.DefaultRouteTable{
+			Spec: svcapitypes.DefaultRouteTableSpec{Routes: routes},
+		}}
+	}
+
+	t.Run("local route is ignored", func(t *testing.T) {
+		a := newResource(route("igw-1", "0.0.0.0/0"))
+		b := newResource(route("local", "10.0.0.0/16"), route("igw-1", "0.0.0.0/0"))
+		delta := ackcompare.NewDelta()
+		customPreCompare(delta, a, b)
+		assert.Empty(t, delta.Differences)
+		// The compared resources are not modified
+		assert.Len(t, b.ko.Spec.Routes, 2)
+	})
+
+	t.Run("route to delete", func(t *testing.T) {
+		a := newResource()
+		b := newResource(route("local", "10.0.0.0/16"), route("igw-1", "0.0.0.0/0"))
+		delta := ackcompare.NewDelta()
+		customPreCompare(delta, a, b)
+		assert.True(t, delta.DifferentAt("Spec.Routes"))
+	})
+
+	t.Run("route propagation to enable", func(t *testing.T) {
+		a := newResource()
+		a.ko.Spec.PropagatingVPNGateways = aws.StringSlice([]string{"vgw-1"})
+		b := newResource()
+		delta := ackcompare.NewDelta()
+		customPreCompare(delta, a, b)
+		assert.True(t, delta.DifferentAt("Spec.PropagatingVPNGateways"))
+		assert.False(t, delta.DifferentAt("Spec.Routes"))
+	})
+}
+
+func TestIsMainRouteTable(t *testing.T) {
+	ko := &svcapitypes.DefaultRouteTable{
+		Spec: svcapitypes.DefaultRouteTableSpec{VPCID: aws.String("vpc-1")},
+		Status: svcapitypes.DefaultRouteTableStatus{
+			RouteTableID: aws.String("rtb-1"),
+			Associations: []*svcapitypes.RouteTableAssociation{{
+				Main:                    aws.Bool(true),
+				RouteTableAssociationID: aws.String("rtbassoc-1"),
+			}},
+		},
+	}
+	assert.True(t, isMainRouteTable(ko))
+
+	// Another route table was made main
+	ko.Status.Associations = nil
+	assert.False(t, isMainRouteTable(ko))
+	err := newMainRouteTableChangedError(ko)
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+	assert.Contains(t, err.Error(), "rtb-1")
+}
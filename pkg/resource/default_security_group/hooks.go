@@ -0,0 +1,296 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package default_security_group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/security_group"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/vpcdefaults"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	// DefaultSecurityGroupName is the name of the default security group of
+	// a VPC.
+	DefaultSecurityGroupName = "default"
+	// DefaultSecurityGroupDescription is the description EC2 gives the default
+	// security group of a VPC.
+	DefaultSecurityGroupDescription = "default VPC security group"
+)
+
+var (
+	ErrDefaultSecurityGroupNotFound = fmt.Errorf(
+		"default security group of the VPC not found",
+	)
+	requeueWaitForDefaultSecurityGroup = ackrequeue.NeededAfter(
+		ErrDefaultSecurityGroupNotFound,
+		5*time.Second,
+	)
+)
+
+// securityGroupDescriptor describes the SecurityGroup resources the default
+// security group is managed as.
+var securityGroupDescriptor = security_group.NewResourceDescriptor()
+
+// securityGroupManager returns a SecurityGroup resource manager for the
+// account and region of rm. Rules and tags of the default security group are
+// synced by it exactly like those of any other security group.
+func (rm *resourceManager) securityGroupManager() (acktypes.AWSResourceManager, error) {
+	return security_group.NewResourceManager(
+		rm.cfg, rm.clientcfg, rm.log, rm.metrics, rm.rr,
+		rm.awsAccountID, rm.awsRegion,
+	)
+}
+
+// newSecurityGroup returns a SecurityGroup resource with a copy of the
+// metadata, Spec and Status of the supplied DefaultSecurityGroup.
+func newSecurityGroup(ko *svcapitypes.DefaultSecurityGroup) acktypes.AWSResource {
+	ko = ko.DeepCopy()
+	return securityGroupDescriptor.ResourceFromRuntimeObject(&svcapitypes.SecurityGroup{
+		ObjectMeta: ko.ObjectMeta,
+		Spec: svcapitypes.SecurityGroupSpec{
+			Description:  aws.String(DefaultSecurityGroupDescription),
+			EgressRules:  ko.Spec.EgressRules,
+			IngressRules: ko.Spec.IngressRules,
+			Name:         aws.String(DefaultSecurityGroupName),
+			Tags:         ko.Spec.Tags,
+			VPCID:        ko.Spec.VPCID,
+			VPCRef:       ko.Spec.VPCRef,
+		},
+		Status: svcapitypes.SecurityGroupStatus{
+			ACKResourceMetadata: ko.Status.ACKResourceMetadata,
+			Conditions:          ko.Status.Conditions,
+			ID:                  ko.Status.ID,
+			Rules:               ko.Status.Rules,
+		},
+	})
+}
+
+// setFromSecurityGroup sets the Spec and Status of ko from the supplied
+// SecurityGroup resource.
+func setFromSecurityGroup(ko *svcapitypes.DefaultSecurityGroup, res acktypes.AWSResource) {
+	sg := res.RuntimeObject().(*svcapitypes.SecurityGroup).DeepCopy()
+	ko.Spec.EgressRules = sg.Spec.EgressRules
+	ko.Spec.IngressRules = sg.Spec.IngressRules
+	ko.Spec.Tags = sg.Spec.Tags
+	ko.Spec.VPCID = sg.Spec.VPCID
+	ko.Spec.VPCRef = sg.Spec.VPCRef
+	ko.Status.ACKResourceMetadata = sg.Status.ACKResourceMetadata
+	ko.Status.Conditions = sg.Status.Conditions
+	ko.Status.ID = sg.Status.ID
+	ko.Status.Rules = sg.Status.Rules
+}
+
+// customFindDefaultSecurityGroup reads the default security group through the
+// SecurityGroup resource manager. The security group is not known until it has
+// been adopted by sdkCreate.
+func (rm *resourceManager) customFindDefaultSecurityGroup(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindDefaultSecurityGroup")
+	defer func() {
+		exit(err)
+	}()
+
+	observed, err := vpcdefaults.Find(ctx, r.ko.Status.ID, rm.securityGroupManager, newSecurityGroup(r.ko))
+	if observed == nil {
+		return nil, err
+	}
+
+	ko := r.ko.DeepCopy()
+	setFromSecurityGroup(ko, observed)
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, err
+}
+
+// customCreateDefaultSecurityGroup adopts the default security group of the
+// VPC, which is created along with the VPC, and updates it to match the
+// desired state.
+func (rm *resourceManager) customCreateDefaultSecurityGroup(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customCreateDefaultSecurityGroup")
+	defer func() {
+		exit(err)
+	}()
+
+	id, err := rm.getDefaultSecurityGroupID(ctx, desired.ko.Spec.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	if id == nil {
+		return nil, requeueWaitForDefaultSecurityGroup
+	}
+
+	ko := desired.ko.DeepCopy()
+	ko.Status.ID = id
+	rm.setStatusDefaults(ko)
+	return vpcdefaults.Adopt(ctx, &resource{ko}, rm.sdkFind, newResourceDelta, rm.sdkUpdate)
+}
+
+// customUpdateDefaultSecurityGroup updates the default security group through
+// the SecurityGroup resource manager.
+func (rm *resourceManager) customUpdateDefaultSecurityGroup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateDefaultSecurityGroup")
+	defer func() {
+		exit(err)
+	}()
+
+	mgr, err := rm.securityGroupManager()
+	if err != nil {
+		return nil, err
+	}
+	sgDelta := securityGroupDescriptor.Delta(newSecurityGroup(desired.ko), newSecurityGroup(latest.ko))
+	res, err := mgr.Update(ctx, newSecurityGroup(desired.ko), newSecurityGroup(latest.ko), sgDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	updated = rm.concreteResource(desired.DeepCopy())
+	setFromSecurityGroup(updated.ko, res)
+	return updated, nil
+}
+
+// customDeleteDefaultSecurityGroup resets the default security group to the
+// state it had when the VPC was created: a rule allowing all inbound traffic
+// from the security group itself, a rule allowing all outbound traffic and
+// none of the tags set through the resource. The security group itself is
+// deleted along with the VPC.
+func (rm *resourceManager) customDeleteDefaultSecurityGroup(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteDefaultSecurityGroup")
+	defer func() {
+		exit(err)
+	}()
+
+	latest, err = rm.sdkFind(ctx, r)
+	if err == ackerr.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ipv6, err := vpcdefaults.HasIPv6CIDRBlock(ctx, rm.sdkapi, rm.metrics, latest.ko.Spec.VPCID)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := latest.ko.DeepCopy()
+	ko.Spec.IngressRules, ko.Spec.EgressRules = defaultRules(*latest.ko.Status.ID, ipv6)
+	ko.Spec.Tags = vpcdefaults.RemoveTags(latest.ko.Spec.Tags, r.ko.Spec.Tags)
+	delta := newResourceDelta(&resource{ko}, latest)
+	if len(delta.Differences) == 0 {
+		return nil, nil
+	}
+	_, err = rm.sdkUpdate(ctx, &resource{ko}, latest, delta)
+	return nil, err
+}
+
+// defaultRules returns the rules EC2 creates in the default security group of
+// a VPC: an ingress rule allowing all traffic from the security group itself,
+// and an egress rule allowing all IPv4 traffic, and IPv6 traffic if the VPC has
+// an IPv6 CIDR block.
+func defaultRules(
+	groupID string,
+	ipv6 bool,
+) (ingress, egress []*svcapitypes.IPPermission) {
+	ingress = []*svcapitypes.IPPermission{{
+		IPProtocol: aws.String("-1"),
+		UserIDGroupPairs: []*svcapitypes.UserIDGroupPair{{
+			GroupID: aws.String(groupID),
+		}},
+	}}
+	allTraffic := &svcapitypes.IPPermission{
+		IPProtocol: aws.String("-1"),
+		IPRanges: []*svcapitypes.IPRange{{
+			CIDRIP: aws.String("0.0.0.0/0"),
+		}},
+	}
+	if ipv6 {
+		allTraffic.IPv6Ranges = []*svcapitypes.IPv6Range{{
+			CIDRIPv6: aws.String("::/0"),
+		}}
+	}
+	egress = []*svcapitypes.IPPermission{allTraffic}
+	return ingress, egress
+}
+
+// getDefaultSecurityGroupID returns the ID of the default security group of
+// the VPC, or nil if there is none.
+func (rm *resourceManager) getDefaultSecurityGroupID(
+	ctx context.Context,
+	vpcID *string,
+) (*string, error) {
+	input := &svcsdk.DescribeSecurityGroupsInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{*vpcID},
+			},
+			{
+				Name:   aws.String("group-name"),
+				Values: []string{DefaultSecurityGroupName},
+			},
+		},
+	}
+	resp, err := rm.sdkapi.DescribeSecurityGroups(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeSecurityGroups", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.SecurityGroups) == 0 {
+		return nil, nil
+	}
+	return resp.SecurityGroups[0].GroupId, nil
+}
+
+// customPreCompare compares Spec.IngressRules and Spec.EgressRules the same
+// way they are compared for SecurityGroup resources.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	sgDelta := securityGroupDescriptor.Delta(newSecurityGroup(a.ko), newSecurityGroup(b.ko))
+	if sgDelta.DifferentAt("Spec.IngressRules") {
+		delta.Add("Spec.IngressRules", a.ko.Spec.IngressRules, b.ko.Spec.IngressRules)
+	}
+	if sgDelta.DifferentAt("Spec.EgressRules") {
+		delta.Add("Spec.EgressRules", a.ko.Spec.EgressRules, b.ko.Spec.EgressRules)
+	}
+}
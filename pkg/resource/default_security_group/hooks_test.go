@@ -0,0 +1,72 @@
+package default_security_group
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestResource(ingress, egress []*svcapitypes.IPPermission) *resource {
+	owner := ackv1alpha1.AWSAccountID("111122223333")
+	return &resource{ko: &svcapitypes.DefaultSecurityGroup{
+		Spec: svcapitypes.DefaultSecurityGroupSpec{
+			EgressRules:  egress,
+			IngressRules: ingress,
+			VPCID:        aws.String("vpc-1"),
+		},
+		Status: svcapitypes.DefaultSecurityGroupStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{OwnerAccountID: &owner},
+			ID:                  aws.String("sg-1"),
+		},
+	}}
+}
+
+func TestSecurityGroupConversion(t *testing.T) {
+	ingress, egress := defaultRules("sg-1", false)
+	r := newTestResource(ingress, egress)
+
+	res := newSecurityGroup(r.ko)
+	sg := res.RuntimeObject().(*svcapitypes.SecurityGroup)
+	assert.Equal(t, DefaultSecurityGroupName, *sg.Spec.Name)
+	assert.Equal(t, DefaultSecurityGroupDescription, *sg.Spec.Description)
+	assert.Equal(t, "sg-1", *sg.Status.ID)
+
+	got := &svcapitypes.DefaultSecurityGroup{}
+	setFromSecurityGroup(got, res)
+	assert.Equal(t, r.ko.Spec, got.Spec)
+	assert.Equal(t, r.ko.Status, got.Status)
+}
+
+func TestDefaultRules(t *testing.T) {
+	ingress, egress := defaultRules("sg-1", false)
+	assert.Len(t, ingress, 1)
+	assert.Equal(t, "-1", *ingress[0].IPProtocol)
+	assert.Equal(t, "sg-1", *ingress[0].UserIDGroupPairs[0].GroupID)
+	assert.Len(t, egress, 1)
+	assert.Equal(t, "0.0.0.0/0", *egress[0].IPRanges[0].CIDRIP)
+	assert.Empty(t, egress[0].IPv6Ranges)
+
+	_, egress = defaultRules("sg-1", true)
+	assert.Equal(t, "::/0", *egress[0].IPv6Ranges[0].CIDRIPv6)
+}
+
+func TestCustomPreCompare(t *testing.T) {
+	ingress, egress := defaultRules("sg-1", false)
+
+	// AWS returns the owner of the security group in self-referencing rules
+	latestIngress, _ := defaultRules("sg-1", false)
+	latestIngress[0].UserIDGroupPairs[0].UserID = aws.String("111122223333")
+
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, newTestResource(ingress, egress), newTestResource(latestIngress, egress))
+	assert.Empty(t, delta.Differences)
+
+	delta = ackcompare.NewDelta()
+	customPreCompare(delta, newTestResource(nil, egress), newTestResource(latestIngress, egress))
+	assert.True(t, delta.DifferentAt("Spec.IngressRules"))
+	assert.False(t, delta.DifferentAt("Spec.EgressRules"))
+}
@@ -288,6 +288,9 @@ func newResourceDelta(
 			delta.Add("Spec.KeyName", a.ko.Spec.KeyName, b.ko.Spec.KeyName)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.KeyRef, b.ko.Spec.KeyRef) {
+		delta.Add("Spec.KeyRef", a.ko.Spec.KeyRef, b.ko.Spec.KeyRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.LaunchTemplate, b.ko.Spec.LaunchTemplate) {
 		delta.Add("Spec.LaunchTemplate", a.ko.Spec.LaunchTemplate, b.ko.Spec.LaunchTemplate)
 	} else if a.ko.Spec.LaunchTemplate != nil && b.ko.Spec.LaunchTemplate != nil {
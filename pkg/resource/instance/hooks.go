@@ -176,6 +176,15 @@ func setAdditionalFields(instance svcsdktypes.Instance, ko *v1alpha1.Instance) {
 	}
 }
 
+// setPlacementGroupRef copies Placement.GroupRef, which is not returned by
+// EC2, from the desired instance to the latest one.
+func setPlacementGroupRef(desired *v1alpha1.Instance, latest *v1alpha1.Instance) {
+	if desired.Spec.Placement == nil || latest.Spec.Placement == nil {
+		return
+	}
+	latest.Spec.Placement.GroupRef = desired.Spec.Placement.GroupRef
+}
+
 var computeTagsDelta = tags.ComputeTagsDelta
 
 // updateTagSpecificationsInCreateRequest adds
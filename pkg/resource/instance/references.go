@@ -38,12 +38,22 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.KeyRef != nil {
+		ko.Spec.KeyName = nil
+	}
+
 	if ko.Spec.LaunchTemplate != nil {
 		if ko.Spec.LaunchTemplate.LaunchTemplateRef != nil {
 			ko.Spec.LaunchTemplate.LaunchTemplateID = nil
 		}
 	}
 
+	if ko.Spec.Placement != nil {
+		if ko.Spec.Placement.GroupRef != nil {
+			ko.Spec.Placement.GroupName = nil
+		}
+	}
+
 	if ko.Spec.SubnetRef != nil {
 		ko.Spec.SubnetID = nil
 	}
@@ -67,12 +77,24 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForKeyName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForLaunchTemplate_LaunchTemplateID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForPlacement_GroupName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForSubnetID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -86,18 +108,119 @@ func (rm *resourceManager) ResolveReferences(
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.Instance) error {
 
+	if ko.Spec.KeyRef != nil && ko.Spec.KeyName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("KeyName", "KeyRef")
+	}
+
 	if ko.Spec.LaunchTemplate != nil {
 		if ko.Spec.LaunchTemplate.LaunchTemplateRef != nil && ko.Spec.LaunchTemplate.LaunchTemplateID != nil {
 			return ackerr.ResourceReferenceAndIDNotSupportedFor("LaunchTemplate.LaunchTemplateID", "LaunchTemplate.LaunchTemplateRef")
 		}
 	}
 
+	if ko.Spec.Placement != nil {
+		if ko.Spec.Placement.GroupRef != nil && ko.Spec.Placement.GroupName != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Placement.GroupName", "Placement.GroupRef")
+		}
+	}
+
 	if ko.Spec.SubnetRef != nil && ko.Spec.SubnetID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("SubnetID", "SubnetRef")
 	}
 	return nil
 }
 
+// resolveReferenceForKeyName reads the resource referenced
+// from KeyRef field and sets the KeyName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForKeyName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Instance,
+) (hasReferences bool, err error) {
+	if ko.Spec.KeyRef != nil && ko.Spec.KeyRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.KeyRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: KeyRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.KeyPair{}
+		if err := getReferencedResourceState_KeyPair(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.KeyName = (*string)(obj.Spec.KeyName)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_KeyPair looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_KeyPair(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.KeyPair,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"KeyPair",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"KeyPair",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"KeyPair",
+			namespace, name)
+	}
+	if obj.Spec.KeyName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"KeyPair",
+			namespace, name,
+			"Spec.KeyName")
+	}
+	return nil
+}
+
 // resolveReferenceForLaunchTemplate_LaunchTemplateID reads the resource referenced
 // from LaunchTemplate.LaunchTemplateRef field and sets the LaunchTemplate.LaunchTemplateID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -191,6 +314,99 @@ func getReferencedResourceState_LaunchTemplate(
 	return nil
 }
 
+// resolveReferenceForPlacement_GroupName reads the resource referenced
+// from Placement.GroupRef field and sets the Placement.GroupName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForPlacement_GroupName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Instance,
+) (hasReferences bool, err error) {
+	if ko.Spec.Placement != nil {
+		if ko.Spec.Placement.GroupRef != nil && ko.Spec.Placement.GroupRef.From != nil {
+			hasReferences = true
+			arr := ko.Spec.Placement.GroupRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Placement.GroupRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.PlacementGroup{}
+			if err := getReferencedResourceState_PlacementGroup(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Placement.GroupName = (*string)(obj.Spec.GroupName)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_PlacementGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_PlacementGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.PlacementGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"PlacementGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"PlacementGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"PlacementGroup",
+			namespace, name)
+	}
+	if obj.Spec.GroupName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"PlacementGroup",
+			namespace, name,
+			"Spec.GroupName")
+	}
+	return nil
+}
+
 // resolveReferenceForSubnetID reads the resource referenced
 // from SubnetRef field and sets the SubnetID
 // from referenced resource. Returns a boolean indicating whether a reference
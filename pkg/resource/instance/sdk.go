@@ -649,6 +649,7 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	setAdditionalFields(resp.Reservations[0].Instances[0], ko)
+	setPlacementGroupRef(r.ko, ko)
 
 	if !isRunning(ko) {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, aws.String("waiting for resource to be running"))
@@ -1270,6 +1271,7 @@ func (rm *resourceManager) sdkCreate(
 	rm.setStatusDefaults(ko)
 
 	setAdditionalFields(resp.Instances[0], ko)
+	setPlacementGroupRef(desired.ko, ko)
 
 	toAdd, toDelete := computeTagsDelta(desired.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
@@ -0,0 +1,231 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ipam
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+// checkForMissingRequiredFields returns true if the IPAMID used to describe
+// the IPAM has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.IPAMID == nil
+}
+
+// isIPAMDeleted returns true if the IPAM has been deleted. Deleted IPAMs are
+// still returned by DescribeIpams for a short while.
+func isIPAMDeleted(r *resource) bool {
+	return r.ko.Status.State != nil &&
+		*r.ko.Status.State == string(svcsdktypes.IpamStateDeleteComplete)
+}
+
+// isIPAMPending returns true while the IPAM is being created, modified or
+// deleted.
+func isIPAMPending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	switch svcsdktypes.IpamState(*r.ko.Status.State) {
+	case svcsdktypes.IpamStateCreateInProgress,
+		svcsdktypes.IpamStateModifyInProgress,
+		svcsdktypes.IpamStateDeleteInProgress,
+		svcsdktypes.IpamStateIsolateInProgress,
+		svcsdktypes.IpamStateRestoreInProgress:
+		return true
+	}
+	return false
+}
+
+// customPreCompare compares the operating Regions of the IPAM as a set. The
+// home Region of the IPAM is always an operating Region, so it does not need
+// to be listed in the Spec.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	toAdd, toRemove := getOperatingRegionsDifference(
+		a.ko.Spec.OperatingRegions,
+		b.ko.Spec.OperatingRegions,
+		b.ko.Status.IPAMRegion,
+	)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.OperatingRegions", a.ko.Spec.OperatingRegions, b.ko.Spec.OperatingRegions)
+	}
+}
+
+// getOperatingRegionsDifference returns the Regions that are in desired but
+// not in latest (toAdd), and those in latest but not in desired (toRemove).
+// The home Region is never returned in toRemove.
+func getOperatingRegionsDifference(
+	desired []*svcapitypes.AddIPAMOperatingRegion,
+	latest []*svcapitypes.AddIPAMOperatingRegion,
+	homeRegion *string,
+) (toAdd []string, toRemove []string) {
+	desiredSet := operatingRegionSet(desired)
+	if homeRegion != nil {
+		desiredSet[*homeRegion] = struct{}{}
+	}
+	latestSet := operatingRegionSet(latest)
+	for _, region := range desired {
+		if region == nil || region.RegionName == nil {
+			continue
+		}
+		if _, ok := latestSet[*region.RegionName]; !ok {
+			toAdd = append(toAdd, *region.RegionName)
+			latestSet[*region.RegionName] = struct{}{}
+		}
+	}
+	for _, region := range latest {
+		if region == nil || region.RegionName == nil {
+			continue
+		}
+		if _, ok := desiredSet[*region.RegionName]; !ok {
+			toRemove = append(toRemove, *region.RegionName)
+			desiredSet[*region.RegionName] = struct{}{}
+		}
+	}
+	return toAdd, toRemove
+}
+
+func operatingRegionSet(
+	regions []*svcapitypes.AddIPAMOperatingRegion,
+) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, region := range regions {
+		if region != nil && region.RegionName != nil {
+			set[*region.RegionName] = struct{}{}
+		}
+	}
+	return set
+}
+
+func (rm *resourceManager) customUpdateIPAM(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateIPAM")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.IPAMID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentExcept("Spec.Tags") {
+		if err := rm.modifyIPAM(ctx, desired, latest, delta); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// modifyIPAM calls ModifyIpam with the fields that differ between the
+// desired and latest state.
+func (rm *resourceManager) modifyIPAM(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyIPAM")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.ModifyIpamInput{
+		IpamId: latest.ko.Status.IPAMID,
+	}
+	if delta.DifferentAt("Spec.Description") {
+		input.Description = desired.ko.Spec.Description
+	}
+	if delta.DifferentAt("Spec.EnablePrivateGua") {
+		input.EnablePrivateGua = desired.ko.Spec.EnablePrivateGua
+	}
+	if delta.DifferentAt("Spec.MeteredAccount") && desired.ko.Spec.MeteredAccount != nil {
+		input.MeteredAccount = svcsdktypes.IpamMeteredAccount(*desired.ko.Spec.MeteredAccount)
+	}
+	if delta.DifferentAt("Spec.Tier") && desired.ko.Spec.Tier != nil {
+		input.Tier = svcsdktypes.IpamTier(*desired.ko.Spec.Tier)
+	}
+	if delta.DifferentAt("Spec.OperatingRegions") {
+		toAdd, toRemove := getOperatingRegionsDifference(
+			desired.ko.Spec.OperatingRegions,
+			latest.ko.Spec.OperatingRegions,
+			latest.ko.Status.IPAMRegion,
+		)
+		for _, region := range toAdd {
+			input.AddOperatingRegions = append(input.AddOperatingRegions, svcsdktypes.AddIpamOperatingRegion{
+				RegionName: &region,
+			})
+		}
+		for _, region := range toRemove {
+			input.RemoveOperatingRegions = append(input.RemoveOperatingRegions, svcsdktypes.RemoveIpamOperatingRegion{
+				RegionName: &region,
+			})
+		}
+	}
+
+	_, err = rm.sdkapi.ModifyIpam(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyIpam", err)
+	return err
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateIpamInput.TagSpecification
+// and ensures the ResourceType is always set to 'ipam'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateIpamInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "ipam"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
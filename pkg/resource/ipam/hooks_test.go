@@ -0,0 +1,89 @@
+package ipam
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPreCompare(t *testing.T) {
+	regions := func(names ...string) []*svcapitypes.AddIPAMOperatingRegion {
+		res := []*svcapitypes.AddIPAMOperatingRegion{}
+		for _, name := range names {
+			res = append(res, &svcapitypes.AddIPAMOperatingRegion{RegionName: aws.String(name)})
+		}
+		return res
+	}
+
+	tt := []struct {
+		id       string
+		desired  []*svcapitypes.AddIPAMOperatingRegion
+		latest   []*svcapitypes.AddIPAMOperatingRegion
+		toAdd    []string
+		toRemove []string
+	}{
+		{"home region implied",
+			nil, regions("us-west-2"),
+			nil, nil,
+		},
+		{"different order",
+			regions("us-east-1", "us-west-2"), regions("us-west-2", "us-east-1"),
+			nil, nil,
+		},
+		{"add region",
+			regions("us-east-1"), regions("us-west-2"),
+			[]string{"us-east-1"}, nil,
+		},
+		{"remove region",
+			regions("us-west-2"), regions("us-west-2", "eu-west-1"),
+			nil, []string{"eu-west-1"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.IPAM{
+				Spec: svcapitypes.IPAMSpec{OperatingRegions: tc.desired},
+			}}
+			b := &resource{ko: &svcapitypes.IPAM{
+				Spec:   svcapitypes.IPAMSpec{OperatingRegions: tc.latest},
+				Status: svcapitypes.IPAMStatus{IPAMRegion: aws.String("us-west-2")},
+			}}
+
+			toAdd, toRemove := getOperatingRegionsDifference(tc.desired, tc.latest, b.ko.Status.IPAMRegion)
+			assert.Equal(t, tc.toAdd, toAdd)
+			assert.Equal(t, tc.toRemove, toRemove)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, len(toAdd) > 0 || len(toRemove) > 0, delta.DifferentAt("Spec.OperatingRegions"))
+		})
+	}
+}
+
+func TestIPAMState(t *testing.T) {
+	tt := []struct {
+		state   string
+		deleted bool
+		pending bool
+	}{
+		{"create-in-progress", false, true},
+		{"create-complete", false, false},
+		{"modify-in-progress", false, true},
+		{"delete-in-progress", false, true},
+		{"delete-complete", true, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.state, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.IPAM{
+				Status: svcapitypes.IPAMStatus{State: aws.String(tc.state)},
+			}}
+			assert.Equal(t, tc.deleted, isIPAMDeleted(r))
+			assert.Equal(t, tc.pending, isIPAMPending(r))
+		})
+	}
+}
@@ -0,0 +1,489 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ipam_pool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrIPAMPoolPending = fmt.Errorf(
+		"IPAM pool or its CIDRs are in a transitional state, cannot be modified",
+	)
+	requeueWaitWhilePending = ackrequeue.NeededAfter(
+		ErrIPAMPoolPending,
+		5*time.Second,
+	)
+	ErrCIDRsDeprovisioning = fmt.Errorf(
+		"IPAM pool CIDRs are being deprovisioned, cannot be deleted",
+	)
+	requeueWaitWhileCIDRsDeprovisioning = ackrequeue.NeededAfter(
+		ErrCIDRsDeprovisioning,
+		5*time.Second,
+	)
+)
+
+// checkForMissingRequiredFields returns true if the IPAMPoolID used to
+// describe the pool has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.IPAMPoolID == nil
+}
+
+// isIPAMPoolDeleted returns true if the pool has been deleted. Deleted pools
+// are still returned by DescribeIpamPools for a short while.
+func isIPAMPoolDeleted(r *resource) bool {
+	return r.ko.Status.State != nil &&
+		*r.ko.Status.State == string(svcsdktypes.IpamPoolStateDeleteComplete)
+}
+
+// isIPAMPoolPending returns true while the pool is being created, modified
+// or deleted.
+func isIPAMPoolPending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	switch svcsdktypes.IpamPoolState(*r.ko.Status.State) {
+	case svcsdktypes.IpamPoolStateCreateInProgress,
+		svcsdktypes.IpamPoolStateModifyInProgress,
+		svcsdktypes.IpamPoolStateDeleteInProgress,
+		svcsdktypes.IpamPoolStateIsolateInProgress,
+		svcsdktypes.IpamPoolStateRestoreInProgress:
+		return true
+	}
+	return false
+}
+
+// areCIDRsProvisioning returns true if any CIDR of the pool is still being
+// provisioned, deprovisioned or imported.
+func areCIDRsProvisioning(r *resource) bool {
+	for _, cidr := range r.ko.Status.ProvisionedCIDRs {
+		if cidr == nil || cidr.State == nil {
+			continue
+		}
+		switch svcsdktypes.IpamPoolCidrState(*cidr.State) {
+		case svcsdktypes.IpamPoolCidrStatePendingProvision,
+			svcsdktypes.IpamPoolCidrStatePendingDeprovision,
+			svcsdktypes.IpamPoolCidrStatePendingImport:
+			return true
+		}
+	}
+	return false
+}
+
+// isCIDRActive returns true if the CIDR is provisioned into the pool, or
+// about to be.
+func isCIDRActive(cidr *svcapitypes.IPAMPoolCIDR) bool {
+	if cidr == nil || cidr.CIDR == nil || cidr.State == nil {
+		return false
+	}
+	switch svcsdktypes.IpamPoolCidrState(*cidr.State) {
+	case svcsdktypes.IpamPoolCidrStatePendingProvision,
+		svcsdktypes.IpamPoolCidrStateProvisioned,
+		svcsdktypes.IpamPoolCidrStatePendingImport,
+		svcsdktypes.IpamPoolCidrStateFailedDeprovision:
+		return true
+	}
+	return false
+}
+
+// customPreCompare compares the desired CIDRs with the CIDRs provisioned into
+// the pool, and the allocation resource tags as a set. CIDRs are only
+// compared when set in the Spec, so pools whose CIDRs are provisioned outside
+// of the controller are left untouched.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.CIDRs != nil {
+		toAdd, toRemove := getCIDRsDifference(a.ko.Spec.CIDRs, b.ko.Status.ProvisionedCIDRs)
+		if len(toAdd) > 0 || len(toRemove) > 0 {
+			delta.Add("Spec.CIDRs", a.ko.Spec.CIDRs, b.ko.Spec.CIDRs)
+		}
+	}
+	toAdd, toRemove := getAllocationResourceTagsDifference(
+		a.ko.Spec.AllocationResourceTags,
+		b.ko.Spec.AllocationResourceTags,
+	)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add(
+			"Spec.AllocationResourceTags",
+			a.ko.Spec.AllocationResourceTags,
+			b.ko.Spec.AllocationResourceTags,
+		)
+	}
+}
+
+// getCIDRsDifference returns the CIDRs that are desired but not provisioned
+// into the pool (toAdd), and those provisioned but no longer desired
+// (toRemove).
+func getCIDRsDifference(
+	desired []*string,
+	provisioned []*svcapitypes.IPAMPoolCIDR,
+) (toAdd []string, toRemove []string) {
+	desiredSet := map[string]struct{}{}
+	for _, cidr := range desired {
+		if cidr != nil {
+			desiredSet[*cidr] = struct{}{}
+		}
+	}
+	provisionedSet := map[string]struct{}{}
+	for _, cidr := range provisioned {
+		if isCIDRActive(cidr) {
+			provisionedSet[*cidr.CIDR] = struct{}{}
+		}
+	}
+	for _, cidr := range desired {
+		if cidr == nil {
+			continue
+		}
+		if _, ok := provisionedSet[*cidr]; !ok {
+			toAdd = append(toAdd, *cidr)
+			provisionedSet[*cidr] = struct{}{}
+		}
+	}
+	for _, cidr := range provisioned {
+		if !isCIDRActive(cidr) {
+			continue
+		}
+		if _, ok := desiredSet[*cidr.CIDR]; !ok {
+			toRemove = append(toRemove, *cidr.CIDR)
+			desiredSet[*cidr.CIDR] = struct{}{}
+		}
+	}
+	return toAdd, toRemove
+}
+
+// getAllocationResourceTagsDifference returns the allocation resource tags
+// that are in desired but not in latest (toAdd), and those in latest but not
+// in desired (toRemove).
+func getAllocationResourceTagsDifference(
+	desired []*svcapitypes.RequestIPAMResourceTag,
+	latest []*svcapitypes.RequestIPAMResourceTag,
+) (toAdd []svcsdktypes.RequestIpamResourceTag, toRemove []svcsdktypes.RequestIpamResourceTag) {
+	desiredSet := allocationResourceTagSet(desired)
+	latestSet := allocationResourceTagSet(latest)
+	for tag := range desiredSet {
+		if _, ok := latestSet[tag]; !ok {
+			toAdd = append(toAdd, svcsdktypes.RequestIpamResourceTag{
+				Key:   aws.String(tag.key),
+				Value: aws.String(tag.value),
+			})
+		}
+	}
+	for tag := range latestSet {
+		if _, ok := desiredSet[tag]; !ok {
+			toRemove = append(toRemove, svcsdktypes.RequestIpamResourceTag{
+				Key:   aws.String(tag.key),
+				Value: aws.String(tag.value),
+			})
+		}
+	}
+	return toAdd, toRemove
+}
+
+type allocationResourceTag struct {
+	key   string
+	value string
+}
+
+func allocationResourceTagSet(
+	tags []*svcapitypes.RequestIPAMResourceTag,
+) map[allocationResourceTag]struct{} {
+	set := map[allocationResourceTag]struct{}{}
+	for _, tag := range tags {
+		if tag == nil || tag.Key == nil {
+			continue
+		}
+		set[allocationResourceTag{
+			key:   *tag.Key,
+			value: aws.ToString(tag.Value),
+		}] = struct{}{}
+	}
+	return set
+}
+
+// setProvisionedCIDRs sets Status.ProvisionedCIDRs from the CIDRs returned by
+// GetIpamPoolCidrs. Deprovisioned CIDRs are omitted.
+func (rm *resourceManager) setProvisionedCIDRs(
+	ctx context.Context,
+	ko *svcapitypes.IPAMPool,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setProvisionedCIDRs")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.GetIpamPoolCidrsInput{
+		IpamPoolId: ko.Status.IPAMPoolID,
+	}
+	cidrs := []*svcapitypes.IPAMPoolCIDR{}
+	for {
+		resp, err := rm.sdkapi.GetIpamPoolCidrs(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "GetIpamPoolCidrs", err)
+		if err != nil {
+			return err
+		}
+		for _, cidr := range resp.IpamPoolCidrs {
+			if cidr.State == svcsdktypes.IpamPoolCidrStateDeprovisioned {
+				continue
+			}
+			cidrs = append(cidrs, newIPAMPoolCIDR(cidr))
+		}
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	ko.Status.ProvisionedCIDRs = cidrs
+	return nil
+}
+
+// newIPAMPoolCIDR returns the IPAMPoolCIDR for a CIDR returned by EC2.
+func newIPAMPoolCIDR(cidr svcsdktypes.IpamPoolCidr) *svcapitypes.IPAMPoolCIDR {
+	res := &svcapitypes.IPAMPoolCIDR{
+		CIDR:           cidr.Cidr,
+		IPAMPoolCIDRID: cidr.IpamPoolCidrId,
+	}
+	if cidr.NetmaskLength != nil {
+		res.NetmaskLength = aws.Int64(int64(*cidr.NetmaskLength))
+	}
+	if cidr.State != "" {
+		res.State = aws.String(string(cidr.State))
+	}
+	if cidr.FailureReason != nil {
+		res.FailureReason = &svcapitypes.IPAMPoolCIDRFailureReason{
+			Message: cidr.FailureReason.Message,
+		}
+		if cidr.FailureReason.Code != "" {
+			res.FailureReason.Code = aws.String(string(cidr.FailureReason.Code))
+		}
+	}
+	return res
+}
+
+func (rm *resourceManager) customUpdateIPAMPool(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateIPAMPool")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	if isIPAMPoolPending(latest) || areCIDRsProvisioning(latest) {
+		return nil, requeueWaitWhilePending
+	}
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+	updated.ko.Status.ProvisionedCIDRs = latest.ko.Status.ProvisionedCIDRs
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.IPAMPoolID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Description") ||
+		delta.DifferentAt("Spec.AllocationDefaultNetmaskLength") ||
+		delta.DifferentAt("Spec.AllocationMaxNetmaskLength") ||
+		delta.DifferentAt("Spec.AllocationMinNetmaskLength") ||
+		delta.DifferentAt("Spec.AllocationResourceTags") ||
+		delta.DifferentAt("Spec.AutoImport") {
+		if err := rm.modifyIPAMPool(ctx, desired, latest, delta); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.CIDRs") {
+		if err := rm.syncCIDRs(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// CIDRs are provisioned and deprovisioned asynchronously. Setting
+		// resource synced condition to false will trigger a requeue of the
+		// resource, which refreshes Status.ProvisionedCIDRs.
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, nil, nil)
+	}
+
+	return updated, nil
+}
+
+// modifyIPAMPool calls ModifyIpamPool with the description, auto import
+// setting and allocation rules that differ between the desired and latest
+// state.
+func (rm *resourceManager) modifyIPAMPool(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyIPAMPool")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.ModifyIpamPoolInput{
+		IpamPoolId: latest.ko.Status.IPAMPoolID,
+	}
+	if delta.DifferentAt("Spec.Description") {
+		input.Description = desired.ko.Spec.Description
+	}
+	if delta.DifferentAt("Spec.AutoImport") {
+		input.AutoImport = desired.ko.Spec.AutoImport
+	}
+	if delta.DifferentAt("Spec.AllocationDefaultNetmaskLength") {
+		if desired.ko.Spec.AllocationDefaultNetmaskLength == nil {
+			input.ClearAllocationDefaultNetmaskLength = aws.Bool(true)
+		} else {
+			input.AllocationDefaultNetmaskLength = aws.Int32(int32(*desired.ko.Spec.AllocationDefaultNetmaskLength))
+		}
+	}
+	if delta.DifferentAt("Spec.AllocationMaxNetmaskLength") && desired.ko.Spec.AllocationMaxNetmaskLength != nil {
+		input.AllocationMaxNetmaskLength = aws.Int32(int32(*desired.ko.Spec.AllocationMaxNetmaskLength))
+	}
+	if delta.DifferentAt("Spec.AllocationMinNetmaskLength") && desired.ko.Spec.AllocationMinNetmaskLength != nil {
+		input.AllocationMinNetmaskLength = aws.Int32(int32(*desired.ko.Spec.AllocationMinNetmaskLength))
+	}
+	if delta.DifferentAt("Spec.AllocationResourceTags") {
+		input.AddAllocationResourceTags, input.RemoveAllocationResourceTags = getAllocationResourceTagsDifference(
+			desired.ko.Spec.AllocationResourceTags,
+			latest.ko.Spec.AllocationResourceTags,
+		)
+	}
+
+	_, err = rm.sdkapi.ModifyIpamPool(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyIpamPool", err)
+	return err
+}
+
+// syncCIDRs provisions the desired CIDRs that are not provisioned into the
+// pool yet, and deprovisions the CIDRs that are no longer desired.
+func (rm *resourceManager) syncCIDRs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncCIDRs")
+	defer func(err error) { exit(err) }(err)
+
+	toAdd, toRemove := getCIDRsDifference(desired.ko.Spec.CIDRs, latest.ko.Status.ProvisionedCIDRs)
+	for _, cidr := range toRemove {
+		_, err = rm.sdkapi.DeprovisionIpamPoolCidr(ctx, &svcsdk.DeprovisionIpamPoolCidrInput{
+			IpamPoolId: latest.ko.Status.IPAMPoolID,
+			Cidr:       aws.String(cidr),
+		})
+		rm.metrics.RecordAPICall("UPDATE", "DeprovisionIpamPoolCidr", err)
+		if err != nil {
+			return err
+		}
+	}
+	for _, cidr := range toAdd {
+		_, err = rm.sdkapi.ProvisionIpamPoolCidr(ctx, &svcsdk.ProvisionIpamPoolCidrInput{
+			IpamPoolId: latest.ko.Status.IPAMPoolID,
+			Cidr:       aws.String(cidr),
+		})
+		rm.metrics.RecordAPICall("UPDATE", "ProvisionIpamPoolCidr", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deprovisionCIDRs deprovisions all CIDRs of the pool before it is deleted,
+// and returns a requeue error until they are deprovisioned.
+func (rm *resourceManager) deprovisionCIDRs(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deprovisionCIDRs")
+	defer func(err error) { exit(err) }(err)
+
+	pending := false
+	for _, cidr := range r.ko.Status.ProvisionedCIDRs {
+		if cidr == nil || cidr.State == nil {
+			continue
+		}
+		switch svcsdktypes.IpamPoolCidrState(*cidr.State) {
+		case svcsdktypes.IpamPoolCidrStateProvisioned,
+			svcsdktypes.IpamPoolCidrStateFailedDeprovision:
+			_, err = rm.sdkapi.DeprovisionIpamPoolCidr(ctx, &svcsdk.DeprovisionIpamPoolCidrInput{
+				IpamPoolId: r.ko.Status.IPAMPoolID,
+				Cidr:       cidr.CIDR,
+			})
+			rm.metrics.RecordAPICall("DELETE", "DeprovisionIpamPoolCidr", err)
+			if err != nil {
+				return err
+			}
+			pending = true
+		case svcsdktypes.IpamPoolCidrStatePendingProvision,
+			svcsdktypes.IpamPoolCidrStatePendingDeprovision,
+			svcsdktypes.IpamPoolCidrStatePendingImport:
+			pending = true
+		}
+	}
+	if pending {
+		return requeueWaitWhileCIDRsDeprovisioning
+	}
+	return nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateIpamPoolInput.TagSpecification
+// and ensures the ResourceType is always set to 'ipam-pool'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateIpamPoolInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "ipam-pool"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,178 @@
+package ipam_pool
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func poolCIDR(cidr, state string) *svcapitypes.IPAMPoolCIDR {
+	return &svcapitypes.IPAMPoolCIDR{
+		CIDR:  aws.String(cidr),
+		State: aws.String(state),
+	}
+}
+
+func TestCustomPreCompare(t *testing.T) {
+	tt := []struct {
+		id           string
+		desired      []*string
+		provisioned  []*svcapitypes.IPAMPoolCIDR
+		toAdd        []string
+		toRemove     []string
+		cidrsDiffers bool
+	}{
+		{"unmanaged CIDRs",
+			nil, []*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "provisioned")},
+			nil, []string{"10.0.0.0/16"}, false,
+		},
+		{"provisioned",
+			aws.StringSlice([]string{"10.0.0.0/16"}),
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "provisioned")},
+			nil, nil, false,
+		},
+		{"pending provision",
+			aws.StringSlice([]string{"10.0.0.0/16"}),
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "pending-provision")},
+			nil, nil, false,
+		},
+		{"failed provision is retried",
+			aws.StringSlice([]string{"10.0.0.0/16"}),
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "failed-provision")},
+			[]string{"10.0.0.0/16"}, nil, true,
+		},
+		{"replace CIDR",
+			aws.StringSlice([]string{"10.1.0.0/16"}),
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "provisioned")},
+			[]string{"10.1.0.0/16"}, []string{"10.0.0.0/16"}, true,
+		},
+		{"remove all CIDRs",
+			[]*string{},
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "provisioned")},
+			nil, []string{"10.0.0.0/16"}, true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.IPAMPool{
+				Spec: svcapitypes.IPAMPoolSpec{CIDRs: tc.desired},
+			}}
+			b := &resource{ko: &svcapitypes.IPAMPool{
+				Spec:   svcapitypes.IPAMPoolSpec{CIDRs: tc.desired},
+				Status: svcapitypes.IPAMPoolStatus{ProvisionedCIDRs: tc.provisioned},
+			}}
+
+			toAdd, toRemove := getCIDRsDifference(tc.desired, tc.provisioned)
+			assert.Equal(t, tc.toAdd, toAdd)
+			assert.Equal(t, tc.toRemove, toRemove)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, tc.cidrsDiffers, delta.DifferentAt("Spec.CIDRs"))
+		})
+	}
+}
+
+func TestAllocationResourceTagsDifference(t *testing.T) {
+	tag := func(key, value string) *svcapitypes.RequestIPAMResourceTag {
+		return &svcapitypes.RequestIPAMResourceTag{Key: aws.String(key), Value: aws.String(value)}
+	}
+
+	toAdd, toRemove := getAllocationResourceTagsDifference(
+		[]*svcapitypes.RequestIPAMResourceTag{tag("env", "prod"), tag("team", "net")},
+		[]*svcapitypes.RequestIPAMResourceTag{tag("team", "net"), tag("env", "dev")},
+	)
+	assert.Equal(t, []svcsdktypes.RequestIpamResourceTag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+	}, toAdd)
+	assert.Equal(t, []svcsdktypes.RequestIpamResourceTag{
+		{Key: aws.String("env"), Value: aws.String("dev")},
+	}, toRemove)
+}
+
+func TestIPAMPoolState(t *testing.T) {
+	tt := []struct {
+		id           string
+		state        string
+		cidrs        []*svcapitypes.IPAMPoolCIDR
+		deleted      bool
+		pending      bool
+		provisioning bool
+	}{
+		{"creating", "create-in-progress", nil,
+			false, true, false},
+		{"available", "create-complete",
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "provisioned")},
+			false, false, false},
+		{"provisioning", "create-complete",
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "pending-provision")},
+			false, false, true},
+		{"deprovisioning", "modify-complete",
+			[]*svcapitypes.IPAMPoolCIDR{poolCIDR("10.0.0.0/16", "pending-deprovision")},
+			false, false, true},
+		{"deleted", "delete-complete", nil,
+			true, false, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.IPAMPool{
+				Status: svcapitypes.IPAMPoolStatus{
+					State:            aws.String(tc.state),
+					ProvisionedCIDRs: tc.cidrs,
+				},
+			}}
+			assert.Equal(t, tc.deleted, isIPAMPoolDeleted(r))
+			assert.Equal(t, tc.pending, isIPAMPoolPending(r))
+			assert.Equal(t, tc.provisioning, areCIDRsProvisioning(r))
+		})
+	}
+}
+
+func TestNewIPAMPoolCIDR(t *testing.T) {
+	netmaskLength := int32(16)
+	cidr := newIPAMPoolCIDR(svcsdktypes.IpamPoolCidr{
+		Cidr:           aws.String("10.0.0.0/16"),
+		IpamPoolCidrId: aws.String("ipam-pool-cidr-1"),
+		NetmaskLength:  &netmaskLength,
+		State:          svcsdktypes.IpamPoolCidrStateFailedProvision,
+		FailureReason: &svcsdktypes.IpamPoolCidrFailureReason{
+			Code:    svcsdktypes.IpamPoolCidrFailureCodeCidrNotAvailable,
+			Message: aws.String("not available"),
+		},
+	})
+
+	assert.Equal(t, "10.0.0.0/16", *cidr.CIDR)
+	assert.Equal(t, int64(16), *cidr.NetmaskLength)
+	assert.Equal(t, "failed-provision", *cidr.State)
+	assert.Equal(t, "cidr-not-available", *cidr.FailureReason.Code)
+}
+
+func TestLateInitializeDefaults(t *testing.T) {
+	desired := &resource{ko: &svcapitypes.IPAMPool{
+		Spec: svcapitypes.IPAMPoolSpec{
+			AddressFamily: aws.String("ipv4"),
+			IPAMScopeID:   aws.String("ipam-scope-1"),
+		},
+	}}
+	observed := desired.ko.DeepCopy()
+	observed.Spec.AllocationMaxNetmaskLength = aws.Int64(32)
+	observed.Spec.AllocationMinNetmaskLength = aws.Int64(0)
+	observed.Spec.AutoImport = aws.Bool(false)
+	observed.Spec.Locale = aws.String("None")
+
+	rm := &resourceManager{}
+	lateInitialized := rm.concreteResource(rm.lateInitializeFromReadOneOutput(&resource{observed}, desired))
+	delta := newResourceDelta(lateInitialized, &resource{observed})
+	assert.Empty(t, delta.Differences)
+
+	// Values set in the Spec are kept
+	desired.ko.Spec.AllocationMaxNetmaskLength = aws.Int64(24)
+	lateInitialized = rm.concreteResource(rm.lateInitializeFromReadOneOutput(&resource{observed}, desired))
+	assert.Equal(t, int64(24), *lateInitialized.ko.Spec.AllocationMaxNetmaskLength)
+}
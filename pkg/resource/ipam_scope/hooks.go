@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ipam_scope
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+// checkForMissingRequiredFields returns true if the IPAMScopeID used to
+// describe the scope has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.IPAMScopeID == nil
+}
+
+// isIPAMScopeDeleted returns true if the scope has been deleted. Deleted
+// scopes are still returned by DescribeIpamScopes for a short while.
+func isIPAMScopeDeleted(r *resource) bool {
+	return r.ko.Status.State != nil &&
+		*r.ko.Status.State == string(svcsdktypes.IpamScopeStateDeleteComplete)
+}
+
+// isIPAMScopePending returns true while the scope is being created, modified
+// or deleted.
+func isIPAMScopePending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	switch svcsdktypes.IpamScopeState(*r.ko.Status.State) {
+	case svcsdktypes.IpamScopeStateCreateInProgress,
+		svcsdktypes.IpamScopeStateModifyInProgress,
+		svcsdktypes.IpamScopeStateDeleteInProgress,
+		svcsdktypes.IpamScopeStateIsolateInProgress,
+		svcsdktypes.IpamScopeStateRestoreInProgress:
+		return true
+	}
+	return false
+}
+
+func (rm *resourceManager) customUpdateIPAMScope(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateIPAMScope")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.IPAMScopeID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Description") {
+		input := &svcsdk.ModifyIpamScopeInput{
+			IpamScopeId: latest.ko.Status.IPAMScopeID,
+			Description: desired.ko.Spec.Description,
+		}
+		_, err = rm.sdkapi.ModifyIpamScope(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "ModifyIpamScope", err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateIpamScopeInput.TagSpecification
+// and ensures the ResourceType is always set to 'ipam-scope'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateIpamScopeInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "ipam-scope"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
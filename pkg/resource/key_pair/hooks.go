@@ -0,0 +1,302 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package key_pair
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrMissingPrivateKeySecretRef = ackerr.NewTerminalError(fmt.Errorf(
+		"privateKeySecretRef must be set when no public key is provided",
+	))
+	ErrMultiplePublicKeySources = ackerr.NewTerminalError(fmt.Errorf(
+		"only one of publicKeyMaterial, publicKeySecretRef and publicKeyConfigMapRef may be set",
+	))
+)
+
+// checkForMissingRequiredFields returns true if the KeyPairID used to describe
+// the key pair has not been set in the Status.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.KeyPairID == nil
+}
+
+// isImportMode returns true if the user supplied a public key, in which case
+// the key pair is imported instead of created by EC2.
+func isImportMode(r *resource) bool {
+	return r.ko.Spec.PublicKeyMaterial != nil ||
+		r.ko.Spec.PublicKeySecretRef != nil ||
+		r.ko.Spec.PublicKeyConfigMapRef != nil
+}
+
+// resolveReferenceForPublicKeyMaterial reads the public key from the ConfigMap
+// referenced by PublicKeyConfigMapRef and sets PublicKeyMaterial. Returns a
+// boolean indicating whether the resource references a ConfigMap, or an error.
+func (rm *resourceManager) resolveReferenceForPublicKeyMaterial(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.KeyPair,
+) (hasReferences bool, err error) {
+	ref := ko.Spec.PublicKeyConfigMapRef
+	if ref == nil {
+		return false, nil
+	}
+	hasReferences = true
+	if ref.Name == "" || ref.Key == "" {
+		return hasReferences, fmt.Errorf("provided configmap reference is missing a name or key: PublicKeyConfigMapRef")
+	}
+
+	cm := &corev1.ConfigMap{}
+	namespacedName := types.NamespacedName{
+		Namespace: ko.ObjectMeta.GetNamespace(),
+		Name:      ref.Name,
+	}
+	if err := apiReader.Get(ctx, namespacedName, cm); err != nil {
+		return hasReferences, err
+	}
+	material, ok := cm.Data[ref.Key]
+	if !ok {
+		data, ok := cm.BinaryData[ref.Key]
+		if !ok {
+			return hasReferences, fmt.Errorf(
+				"key %q not found in configmap %s/%s",
+				ref.Key, namespacedName.Namespace, namespacedName.Name,
+			)
+		}
+		material = string(data)
+	}
+	ko.Spec.PublicKeyMaterial = &material
+
+	return hasReferences, nil
+}
+
+// getPublicKeyMaterial returns the public key to import, either given inline
+// (or resolved from a ConfigMap) or read from the referenced Secret.
+func (rm *resourceManager) getPublicKeyMaterial(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	if r.ko.Spec.PublicKeyMaterial != nil && r.ko.Spec.PublicKeySecretRef != nil {
+		return "", ErrMultiplePublicKeySources
+	}
+	if r.ko.Spec.PublicKeySecretRef != nil {
+		return rm.rr.SecretValueFromReference(ctx, r.ko.Spec.PublicKeySecretRef)
+	}
+	if r.ko.Spec.PublicKeyMaterial == nil {
+		return "", fmt.Errorf("public key material has not been resolved")
+	}
+	return *r.ko.Spec.PublicKeyMaterial, nil
+}
+
+// importKeyPair imports the user supplied public key with ImportKeyPair and
+// returns the created resource.
+func (rm *resourceManager) importKeyPair(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.importKeyPair")
+	defer func() {
+		exit(err)
+	}()
+
+	material, err := rm.getPublicKeyMaterial(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &svcsdk.ImportKeyPairInput{
+		KeyName:           desired.ko.Spec.KeyName,
+		PublicKeyMaterial: []byte(material),
+		TagSpecifications: tagSpecifications(desired),
+	}
+	resp, err := rm.sdkapi.ImportKeyPair(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "ImportKeyPair", err)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	ko.Status.KeyFingerprint = resp.KeyFingerprint
+	ko.Status.KeyPairID = resp.KeyPairId
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// checkPrivateKeySecret ensures the Secret the private key is written to has
+// been specified before the key pair is created, as EC2 does not return the
+// private key again after creation. A key already holding a value in the
+// Secret is never overwritten. A missing Secret fails the write of the
+// private key, after which the key pair is deleted again.
+func (rm *resourceManager) checkPrivateKeySecret(
+	ctx context.Context,
+	r *resource,
+) error {
+	ref := r.ko.Spec.PrivateKeySecretRef
+	if ref == nil {
+		return ErrMissingPrivateKeySecretRef
+	}
+	namespace, err := rm.privateKeySecretNamespace(r)
+	if err != nil {
+		return err
+	}
+	current, err := rm.rr.SecretValueFromReference(ctx, &ackv1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{
+			Name:      ref.Name,
+			Namespace: namespace,
+		},
+		Key: ref.Key,
+	})
+	if err != nil && !errors.Is(err, ackerr.SecretNotFound) {
+		return err
+	}
+	if current != "" {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"key %q of secret %s/%s already holds a value, refusing to overwrite it with a new private key",
+			ref.Key, namespace, ref.Name,
+		))
+	}
+	return nil
+}
+
+// writePrivateKey writes the private key material returned by CreateKeyPair
+// to the Secret referenced by PrivateKeySecretRef.
+func (rm *resourceManager) writePrivateKey(
+	ctx context.Context,
+	r *resource,
+	material *string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writePrivateKey")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	ref := r.ko.Spec.PrivateKeySecretRef
+	if ref == nil || material == nil {
+		return nil
+	}
+	namespace, err := rm.privateKeySecretNamespace(r)
+	if err != nil {
+		return err
+	}
+	rlog.Debug("writing private key to secret", "secret", ref.Name, "key", ref.Key)
+	return rm.rr.WriteToSecret(ctx, *material, namespace, ref.Name, ref.Key)
+}
+
+// privateKeySecretNamespace returns the namespace of the private key Secret,
+// defaulting to the namespace of the KeyPair. A Secret in another namespace
+// is only written to when the controller allows cross-namespace references.
+func (rm *resourceManager) privateKeySecretNamespace(r *resource) (string, error) {
+	ref := r.ko.Spec.PrivateKeySecretRef
+	namespace, _, err := ackrt.ValidateCrossNamespaceReferenceString(
+		rm.cfg.EnableCrossNamespace, r.ko.Namespace, ref.Namespace, ref.Name,
+	)
+	if err != nil {
+		return "", ackerr.NewTerminalError(err)
+	}
+	return namespace, nil
+}
+
+// deleteKeyPair deletes a key pair that could not be fully created. Errors
+// are only logged, as the original failure is returned to the caller.
+func (rm *resourceManager) deleteKeyPair(
+	ctx context.Context,
+	keyPairID *string,
+) {
+	rlog := ackrtlog.FromContext(ctx)
+	_, err := rm.sdkapi.DeleteKeyPair(ctx, &svcsdk.DeleteKeyPairInput{
+		KeyPairId: keyPairID,
+	})
+	rm.metrics.RecordAPICall("DELETE", "DeleteKeyPair", err)
+	if err != nil {
+		rlog.Info("failed to delete key pair", "keyPairID", keyPairID, "error", err)
+	}
+}
+
+func (rm *resourceManager) customUpdateKeyPair(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateKeyPair")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.KeyPairID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// tagSpecifications returns the tag specifications for the tags in the Spec.
+func tagSpecifications(r *resource) []svcsdktypes.TagSpecification {
+	if r.ko.Spec.Tags == nil {
+		return nil
+	}
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	requestedTags := []svcsdktypes.Tag{}
+	for _, desiredTag := range r.ko.Spec.Tags {
+		// Add in tags defined in the Spec
+		tag := svcsdktypes.Tag{}
+		if desiredTag.Key != nil && desiredTag.Value != nil {
+			tag.Key = desiredTag.Key
+			tag.Value = desiredTag.Value
+		}
+		requestedTags = append(requestedTags, tag)
+	}
+	desiredTagSpecs.ResourceType = "key-pair"
+	desiredTagSpecs.Tags = requestedTags
+	return []svcsdktypes.TagSpecification{desiredTagSpecs}
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateKeyPairInput.TagSpecification
+// and ensures the ResourceType is always set to 'key-pair'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateKeyPairInput) {
+	input.TagSpecifications = tagSpecifications(r)
+}
@@ -0,0 +1,172 @@
+package key_pair
+
+import (
+	"context"
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsImportMode(t *testing.T) {
+	tt := []struct {
+		id       string
+		spec     svcapitypes.KeyPairSpec
+		expected bool
+	}{
+		{"create",
+			svcapitypes.KeyPairSpec{
+				PrivateKeySecretRef: &ackv1alpha1.SecretKeyReference{Key: "id_rsa"},
+			},
+			false,
+		},
+		{"inline public key",
+			svcapitypes.KeyPairSpec{PublicKeyMaterial: aws.String("ssh-ed25519 AAAA")},
+			true,
+		},
+		{"public key from secret",
+			svcapitypes.KeyPairSpec{
+				PublicKeySecretRef: &ackv1alpha1.SecretKeyReference{Key: "id_rsa.pub"},
+			},
+			true,
+		},
+		{"public key from configmap",
+			svcapitypes.KeyPairSpec{
+				PublicKeyConfigMapRef: &corev1.ConfigMapKeySelector{Key: "id_rsa.pub"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.KeyPair{Spec: tc.spec}}
+			assert.Equal(t, tc.expected, isImportMode(r))
+		})
+	}
+}
+
+func TestValidateReferenceFields(t *testing.T) {
+	ref := &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "keys"},
+		Key:                  "id_rsa.pub",
+	}
+
+	ko := &svcapitypes.KeyPair{Spec: svcapitypes.KeyPairSpec{PublicKeyConfigMapRef: ref}}
+	assert.NoError(t, validateReferenceFields(ko))
+
+	ko.Spec.PublicKeyMaterial = aws.String("ssh-ed25519 AAAA")
+	assert.Error(t, validateReferenceFields(ko))
+}
+
+func TestTagSpecifications(t *testing.T) {
+	r := &resource{ko: &svcapitypes.KeyPair{}}
+	assert.Nil(t, tagSpecifications(r))
+
+	r.ko.Spec.Tags = []*svcapitypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+	specs := tagSpecifications(r)
+	assert.Len(t, specs, 1)
+	assert.Equal(t, "key-pair", string(specs[0].ResourceType))
+	assert.Equal(t, "k", *specs[0].Tags[0].Key)
+}
+
+// fakeSecretReconciler stores Secret values keyed by namespace/name/key.
+type fakeSecretReconciler struct {
+	acktypes.Reconciler
+	values map[string]string
+}
+
+func (r *fakeSecretReconciler) SecretValueFromReference(
+	_ context.Context,
+	ref *ackv1alpha1.SecretKeyReference,
+) (string, error) {
+	value, ok := r.values[ref.Namespace+"/"+ref.Name+"/"+ref.Key]
+	if !ok {
+		return "", ackerr.SecretNotFound
+	}
+	return value, nil
+}
+
+func (r *fakeSecretReconciler) WriteToSecret(
+	_ context.Context,
+	value string,
+	namespace string,
+	name string,
+	key string,
+) error {
+	r.values[namespace+"/"+name+"/"+key] = value
+	return nil
+}
+
+func TestPrivateKeySecret(t *testing.T) {
+	newResource := func(secretNamespace string) *resource {
+		return &resource{ko: &svcapitypes.KeyPair{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team"},
+			Spec: svcapitypes.KeyPairSpec{
+				PrivateKeySecretRef: &ackv1alpha1.SecretKeyReference{
+					SecretReference: corev1.SecretReference{Name: "keys", Namespace: secretNamespace},
+					Key:             "id_rsa",
+				},
+			},
+		}}
+	}
+
+	tt := []struct {
+		id                   string
+		secretNamespace      string
+		enableCrossNamespace bool
+		existing             map[string]string
+		expectedKey          string
+		expectedErr          bool
+	}{
+		{"defaults to the resource namespace",
+			"", false, map[string]string{}, "team/keys/id_rsa", false,
+		},
+		{"same namespace",
+			"team", false, map[string]string{}, "team/keys/id_rsa", false,
+		},
+		{"cross-namespace rejected",
+			"kube-system", false, map[string]string{}, "", true,
+		},
+		{"cross-namespace allowed",
+			"kube-system", true, map[string]string{}, "kube-system/keys/id_rsa", false,
+		},
+		{"empty value is overwritten",
+			"", false, map[string]string{"team/keys/id_rsa": ""}, "team/keys/id_rsa", false,
+		},
+		{"existing private key is kept",
+			"", false, map[string]string{"team/keys/id_rsa": "old"}, "", true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			rr := &fakeSecretReconciler{values: tc.existing}
+			rm := &resourceManager{
+				cfg: ackcfg.Config{EnableCrossNamespace: tc.enableCrossNamespace},
+				rr:  rr,
+			}
+			r := newResource(tc.secretNamespace)
+
+			err := rm.checkPrivateKeySecret(context.TODO(), r)
+			if tc.expectedErr {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+				assert.Equal(t, tc.existing, rr.values)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.existing, rr.values, "the check must not write to the secret")
+
+			assert.NoError(t, rm.writePrivateKey(context.TODO(), r, aws.String("private")))
+			assert.Equal(t, "private", rr.values[tc.expectedKey])
+		})
+	}
+}
@@ -68,6 +68,7 @@ func (rm *resourceManager) setLatestLaunchTemplateAttributes(
 		}
 		if elem.LaunchTemplateData != nil {
 			ko.Spec.Data = rm.setRequestLaunchTemplateData(elem.LaunchTemplateData)
+			setDataReferences(r.ko.Spec.Data, ko.Spec.Data)
 		} else {
 			ko.Spec.Data = nil
 		}
@@ -92,6 +93,22 @@ func (rm *resourceManager) setLatestLaunchTemplateAttributes(
 	return nil
 }
 
+// setDataReferences copies the reference fields of the desired launch template
+// data, which are not returned by DescribeLaunchTemplateVersions, to the latest
+// launch template data.
+func setDataReferences(
+	desired *svcapitypes.RequestLaunchTemplateData,
+	latest *svcapitypes.RequestLaunchTemplateData,
+) {
+	if desired == nil || latest == nil {
+		return
+	}
+	latest.KeyRef = desired.KeyRef
+	if desired.Placement != nil && latest.Placement != nil {
+		latest.Placement.GroupRef = desired.Placement.GroupRef
+	}
+}
+
 func (rm *resourceManager) newListLaunchTemplateVersionRequestPayload(
 	r *resource,
 ) (*svcsdk.DescribeLaunchTemplateVersionsInput, error) {
@@ -17,9 +17,15 @@ package launch_template
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
@@ -32,6 +38,20 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.KeyRef != nil {
+			ko.Spec.Data.KeyName = nil
+		}
+	}
+
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.Placement != nil {
+			if ko.Spec.Data.Placement.GroupRef != nil {
+				ko.Spec.Data.Placement.GroupName = nil
+			}
+		}
+	}
+
 	return &resource{ko}
 }
 
@@ -47,11 +67,229 @@ func (rm *resourceManager) ResolveReferences(
 	apiReader client.Reader,
 	res acktypes.AWSResource,
 ) (acktypes.AWSResource, bool, error) {
-	return res, false, nil
+	ko := rm.concreteResource(res).ko
+
+	resourceHasReferences := false
+	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForData_KeyName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForData_Placement_GroupName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	return &resource{ko}, resourceHasReferences, err
 }
 
 // validateReferenceFields validates the reference field and corresponding
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.LaunchTemplate) error {
+
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.KeyRef != nil && ko.Spec.Data.KeyName != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Data.KeyName", "Data.KeyRef")
+		}
+	}
+
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.Placement != nil {
+			if ko.Spec.Data.Placement.GroupRef != nil && ko.Spec.Data.Placement.GroupName != nil {
+				return ackerr.ResourceReferenceAndIDNotSupportedFor("Data.Placement.GroupName", "Data.Placement.GroupRef")
+			}
+		}
+	}
+	return nil
+}
+
+// resolveReferenceForData_KeyName reads the resource referenced
+// from Data.KeyRef field and sets the Data.KeyName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForData_KeyName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.LaunchTemplate,
+) (hasReferences bool, err error) {
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.KeyRef != nil && ko.Spec.Data.KeyRef.From != nil {
+			hasReferences = true
+			arr := ko.Spec.Data.KeyRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Data.KeyRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.KeyPair{}
+			if err := getReferencedResourceState_KeyPair(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Data.KeyName = (*string)(obj.Spec.KeyName)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_KeyPair looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_KeyPair(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.KeyPair,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"KeyPair",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"KeyPair",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"KeyPair",
+			namespace, name)
+	}
+	if obj.Spec.KeyName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"KeyPair",
+			namespace, name,
+			"Spec.KeyName")
+	}
+	return nil
+}
+
+// resolveReferenceForData_Placement_GroupName reads the resource referenced
+// from Data.Placement.GroupRef field and sets the Data.Placement.GroupName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForData_Placement_GroupName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.LaunchTemplate,
+) (hasReferences bool, err error) {
+	if ko.Spec.Data != nil {
+		if ko.Spec.Data.Placement != nil {
+			if ko.Spec.Data.Placement.GroupRef != nil && ko.Spec.Data.Placement.GroupRef.From != nil {
+				hasReferences = true
+				arr := ko.Spec.Data.Placement.GroupRef.From
+				if arr.Name == nil || *arr.Name == "" {
+					return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Data.Placement.GroupRef")
+				}
+				namespace, err := ackrt.ResolveCrossNamespaceReference(
+					ctx,
+					rm.cfg.EnableCrossNamespace,
+					&ko.Status.Conditions,
+					ackrt.CrossNamespaceRefKindResource,
+					ko.ObjectMeta.GetNamespace(),
+					arr.Namespace,
+					*arr.Name,
+				)
+				if err != nil {
+					return hasReferences, err
+				}
+				obj := &svcapitypes.PlacementGroup{}
+				if err := getReferencedResourceState_PlacementGroup(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+					return hasReferences, err
+				}
+				ko.Spec.Data.Placement.GroupName = (*string)(obj.Spec.GroupName)
+			}
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_PlacementGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_PlacementGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.PlacementGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"PlacementGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"PlacementGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"PlacementGroup",
+			namespace, name)
+	}
+	if obj.Spec.GroupName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"PlacementGroup",
+			namespace, name,
+			"Spec.GroupName")
+	}
 	return nil
 }
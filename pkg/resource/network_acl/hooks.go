@@ -19,11 +19,17 @@ import (
 	"strconv"
 	"strings"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
 	"github.com/samber/lo"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
@@ -34,6 +40,26 @@ var DefaultRuleNumber = 32767
 var TypeSubnet = "SubnetID"
 var TypeNaclAssocId = "NetworkACLAssociationID"
 
+// NewResourceManager returns a manager for NetworkACL resources in the given
+// account and region. The DefaultNetworkACL resource uses it to manage the
+// default network ACL of a VPC like any other network ACL.
+func NewResourceManager(
+	cfg ackcfg.Config,
+	clientcfg awsv2.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) (acktypes.AWSResourceManager, error) {
+	return newResourceManager(cfg, clientcfg, log, metrics, rr, id, region)
+}
+
+// NewResourceDescriptor returns the descriptor of NetworkACL resources
+func NewResourceDescriptor() acktypes.AWSResourceDescriptor {
+	return &resourceDescriptor{}
+}
+
 func (rm *resourceManager) customUpdateNetworkAcl(
 	ctx context.Context,
 	desired *resource,
@@ -0,0 +1,530 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package network_interface
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrAttachmentDetaching = fmt.Errorf(
+		"NetworkInterface attachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.AttachmentStatusDetaching,
+	)
+)
+
+var (
+	requeueWaitWhileDetaching = ackrequeue.NeededAfter(
+		ErrAttachmentDetaching,
+		10*time.Second,
+	)
+)
+
+// checkForMissingRequiredFields returns true if the NetworkInterfaceID used to
+// describe the resource has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.NetworkInterfaceID == nil
+}
+
+// isAttachmentDetaching returns true if the network interface is still being
+// detached from an instance.
+func isAttachmentDetaching(r *resource) bool {
+	att := r.ko.Status.Attachment
+	if att == nil || att.Status == nil {
+		return false
+	}
+	return *att.Status == string(svcsdktypes.AttachmentStatusDetaching)
+}
+
+// getAttachedInstance returns the ID of the instance the network interface is
+// attached, or being attached, to. If it is not attached, or is being
+// detached, nil is returned.
+func getAttachedInstance(r *resource) *string {
+	att := r.ko.Status.Attachment
+	if att == nil || att.Status == nil {
+		return nil
+	}
+	if *att.Status == string(svcsdktypes.AttachmentStatusAttached) ||
+		*att.Status == string(svcsdktypes.AttachmentStatusAttaching) {
+		return att.InstanceID
+	}
+	return nil
+}
+
+// setAttachment sets Spec.InstanceID and Spec.DeviceIndex from the observed
+// attachment of the network interface.
+func setAttachment(ko *svcapitypes.NetworkInterface) {
+	ko.Spec.InstanceID = getAttachedInstance(&resource{ko})
+	ko.Spec.DeviceIndex = nil
+	if ko.Spec.InstanceID != nil {
+		ko.Spec.DeviceIndex = ko.Status.Attachment.DeviceIndex
+	}
+}
+
+// setObservedFields sets the Spec fields whose shape differs from the one
+// returned by DescribeNetworkInterfaces: the security group IDs, the secondary
+// private IPv4 addresses, the IPv4 and IPv6 prefixes, the IPv6 addresses and
+// the tags.
+func setObservedFields(
+	ko *svcapitypes.NetworkInterface,
+	ni svcsdktypes.NetworkInterface,
+) {
+	ko.Spec.SecurityGroupIDs = nil
+	for _, group := range ni.Groups {
+		ko.Spec.SecurityGroupIDs = append(ko.Spec.SecurityGroupIDs, group.GroupId)
+	}
+
+	ko.Spec.SecondaryPrivateIPAddresses = nil
+	for _, addr := range ni.PrivateIpAddresses {
+		if aws.ToBool(addr.Primary) {
+			continue
+		}
+		ko.Spec.SecondaryPrivateIPAddresses = append(ko.Spec.SecondaryPrivateIPAddresses, addr.PrivateIpAddress)
+	}
+
+	ko.Spec.IPv4Prefixes = nil
+	for _, prefix := range ni.Ipv4Prefixes {
+		ko.Spec.IPv4Prefixes = append(ko.Spec.IPv4Prefixes, prefix.Ipv4Prefix)
+	}
+
+	ko.Spec.IPv6Addresses = nil
+	for _, addr := range ni.Ipv6Addresses {
+		ko.Spec.IPv6Addresses = append(ko.Spec.IPv6Addresses, addr.Ipv6Address)
+	}
+
+	ko.Spec.IPv6Prefixes = nil
+	for _, prefix := range ni.Ipv6Prefixes {
+		ko.Spec.IPv6Prefixes = append(ko.Spec.IPv6Prefixes, prefix.Ipv6Prefix)
+	}
+
+	ko.Spec.Tags = nil
+	for _, tag := range ni.TagSet {
+		ko.Spec.Tags = append(ko.Spec.Tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+}
+
+// customPreCompare compares the list fields of the network interface as sets,
+// since EC2 does not preserve the order in which addresses, prefixes and
+// security groups were assigned. A list that is not set is not compared. The device index is only compared while the
+// network interface is meant to be attached.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.InstanceID != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.DeviceIndex, b.ko.Spec.DeviceIndex) {
+			delta.Add("Spec.DeviceIndex", a.ko.Spec.DeviceIndex, b.ko.Spec.DeviceIndex)
+		} else if a.ko.Spec.DeviceIndex != nil && b.ko.Spec.DeviceIndex != nil {
+			if *a.ko.Spec.DeviceIndex != *b.ko.Spec.DeviceIndex {
+				delta.Add("Spec.DeviceIndex", a.ko.Spec.DeviceIndex, b.ko.Spec.DeviceIndex)
+			}
+		}
+	}
+	toAdd, toRemove := getStringsDifference(a.ko.Spec.IPv4Prefixes, b.ko.Spec.IPv4Prefixes)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.IPv4Prefixes", a.ko.Spec.IPv4Prefixes, b.ko.Spec.IPv4Prefixes)
+	}
+	toAdd, toRemove = getStringsDifference(a.ko.Spec.IPv6Addresses, b.ko.Spec.IPv6Addresses)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.IPv6Addresses", a.ko.Spec.IPv6Addresses, b.ko.Spec.IPv6Addresses)
+	}
+	toAdd, toRemove = getStringsDifference(a.ko.Spec.IPv6Prefixes, b.ko.Spec.IPv6Prefixes)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.IPv6Prefixes", a.ko.Spec.IPv6Prefixes, b.ko.Spec.IPv6Prefixes)
+	}
+	toAdd, toRemove = getStringsDifference(a.ko.Spec.SecondaryPrivateIPAddresses, b.ko.Spec.SecondaryPrivateIPAddresses)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.SecondaryPrivateIPAddresses", a.ko.Spec.SecondaryPrivateIPAddresses, b.ko.Spec.SecondaryPrivateIPAddresses)
+	}
+	toAdd, toRemove = getStringsDifference(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
+	}
+}
+
+// getStringsDifference returns the values that are desired but not present in
+// latest, and those present in latest but no longer desired. A nil desired
+// list is left unmanaged, so that the addresses, prefixes and security groups
+// EC2 assigns by itself, or that were assigned before the network interface
+// was adopted, are kept. An empty list removes them all.
+func getStringsDifference(
+	desired []*string,
+	latest []*string,
+) (toAdd []string, toRemove []string) {
+	if desired == nil {
+		return nil, nil
+	}
+	desiredSet := map[string]struct{}{}
+	for _, v := range desired {
+		if v != nil {
+			desiredSet[*v] = struct{}{}
+		}
+	}
+	latestSet := map[string]struct{}{}
+	for _, v := range latest {
+		if v != nil {
+			latestSet[*v] = struct{}{}
+		}
+	}
+	for _, v := range desired {
+		if v == nil {
+			continue
+		}
+		if _, ok := latestSet[*v]; !ok {
+			toAdd = append(toAdd, *v)
+			latestSet[*v] = struct{}{}
+		}
+	}
+	for _, v := range latest {
+		if v == nil {
+			continue
+		}
+		if _, ok := desiredSet[*v]; !ok {
+			toRemove = append(toRemove, *v)
+			desiredSet[*v] = struct{}{}
+		}
+	}
+	return toAdd, toRemove
+}
+
+func (rm *resourceManager) customUpdateNetworkInterface(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateNetworkInterface")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	eniID := latest.ko.Status.NetworkInterfaceID
+
+	if delta.DifferentAt("Spec.Description") {
+		input := &svcsdk.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: eniID,
+			Description: &svcsdktypes.AttributeValue{
+				Value: aws.String(aws.ToString(desired.ko.Spec.Description)),
+			},
+		}
+		if err = rm.modifyNetworkInterfaceAttribute(ctx, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.SecurityGroupIDs") {
+		input := &svcsdk.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: eniID,
+			Groups:             aws.ToStringSlice(desired.ko.Spec.SecurityGroupIDs),
+		}
+		if err = rm.modifyNetworkInterfaceAttribute(ctx, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.SourceDestCheck") && desired.ko.Spec.SourceDestCheck != nil {
+		input := &svcsdk.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: eniID,
+			SourceDestCheck: &svcsdktypes.AttributeBooleanValue{
+				Value: desired.ko.Spec.SourceDestCheck,
+			},
+		}
+		if err = rm.modifyNetworkInterfaceAttribute(ctx, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.SecondaryPrivateIPAddresses") || delta.DifferentAt("Spec.IPv4Prefixes") {
+		if err = rm.syncPrivateIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.IPv6Addresses") || delta.DifferentAt("Spec.IPv6Prefixes") {
+		if err = rm.syncIPv6Addresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.InstanceID") || delta.DifferentAt("Spec.DeviceIndex") {
+		if err = rm.syncAttachment(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *eniID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+func (rm *resourceManager) modifyNetworkInterfaceAttribute(
+	ctx context.Context,
+	input *svcsdk.ModifyNetworkInterfaceAttributeInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyNetworkInterfaceAttribute")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	_, err = rm.sdkapi.ModifyNetworkInterfaceAttribute(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyNetworkInterfaceAttribute", err)
+	return err
+}
+
+// syncPrivateIPAddresses unassigns the secondary private IPv4 addresses and
+// IPv4 prefixes that are no longer desired, then assigns the new ones.
+func (rm *resourceManager) syncPrivateIPAddresses(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncPrivateIPAddresses")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	addrsToAdd, addrsToRemove := getStringsDifference(
+		desired.ko.Spec.SecondaryPrivateIPAddresses,
+		latest.ko.Spec.SecondaryPrivateIPAddresses,
+	)
+	prefixesToAdd, prefixesToRemove := getStringsDifference(
+		desired.ko.Spec.IPv4Prefixes,
+		latest.ko.Spec.IPv4Prefixes,
+	)
+
+	if len(addrsToRemove) > 0 || len(prefixesToRemove) > 0 {
+		input := &svcsdk.UnassignPrivateIpAddressesInput{
+			NetworkInterfaceId: latest.ko.Status.NetworkInterfaceID,
+			PrivateIpAddresses: addrsToRemove,
+			Ipv4Prefixes:       prefixesToRemove,
+		}
+		_, err = rm.sdkapi.UnassignPrivateIpAddresses(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "UnassignPrivateIpAddresses", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(addrsToAdd) > 0 || len(prefixesToAdd) > 0 {
+		input := &svcsdk.AssignPrivateIpAddressesInput{
+			NetworkInterfaceId: latest.ko.Status.NetworkInterfaceID,
+			PrivateIpAddresses: addrsToAdd,
+			Ipv4Prefixes:       prefixesToAdd,
+		}
+		_, err = rm.sdkapi.AssignPrivateIpAddresses(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "AssignPrivateIpAddresses", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncIPv6Addresses unassigns the IPv6 addresses and IPv6 prefixes that are no
+// longer desired, then assigns the new ones.
+func (rm *resourceManager) syncIPv6Addresses(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncIPv6Addresses")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	addrsToAdd, addrsToRemove := getStringsDifference(
+		desired.ko.Spec.IPv6Addresses,
+		latest.ko.Spec.IPv6Addresses,
+	)
+	prefixesToAdd, prefixesToRemove := getStringsDifference(
+		desired.ko.Spec.IPv6Prefixes,
+		latest.ko.Spec.IPv6Prefixes,
+	)
+
+	if len(addrsToRemove) > 0 || len(prefixesToRemove) > 0 {
+		input := &svcsdk.UnassignIpv6AddressesInput{
+			NetworkInterfaceId: latest.ko.Status.NetworkInterfaceID,
+			Ipv6Addresses:      addrsToRemove,
+			Ipv6Prefixes:       prefixesToRemove,
+		}
+		_, err = rm.sdkapi.UnassignIpv6Addresses(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "UnassignIpv6Addresses", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(addrsToAdd) > 0 || len(prefixesToAdd) > 0 {
+		input := &svcsdk.AssignIpv6AddressesInput{
+			NetworkInterfaceId: latest.ko.Status.NetworkInterfaceID,
+			Ipv6Addresses:      addrsToAdd,
+			Ipv6Prefixes:       prefixesToAdd,
+		}
+		_, err = rm.sdkapi.AssignIpv6Addresses(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "AssignIpv6Addresses", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncAttachment attaches the network interface to the desired instance at
+// the desired device index, detaching it from the currently attached instance
+// first. The new attachment is only created once the old one is fully
+// detached.
+func (rm *resourceManager) syncAttachment(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncAttachment")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	if isAttachmentDetaching(latest) {
+		return requeueWaitWhileDetaching
+	}
+
+	if getAttachedInstance(latest) != nil {
+		if err = rm.detachNetworkInterface(ctx, *latest.ko.Status.Attachment.AttachmentID); err != nil {
+			return err
+		}
+		if desired.ko.Spec.InstanceID != nil {
+			return requeueWaitWhileDetaching
+		}
+		return nil
+	}
+
+	if desired.ko.Spec.InstanceID != nil {
+		if err = rm.attachNetworkInterface(ctx, desired, *latest.ko.Status.NetworkInterfaceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) attachNetworkInterface(
+	ctx context.Context,
+	desired *resource,
+	eniID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.attachNetworkInterface")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.AttachNetworkInterfaceInput{
+		InstanceId:         desired.ko.Spec.InstanceID,
+		NetworkInterfaceId: &eniID,
+	}
+	if desired.ko.Spec.DeviceIndex != nil {
+		input.DeviceIndex = aws.Int32(int32(*desired.ko.Spec.DeviceIndex))
+	}
+
+	_, err = rm.sdkapi.AttachNetworkInterface(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AttachNetworkInterface", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) detachNetworkInterface(
+	ctx context.Context,
+	attachmentID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.detachNetworkInterface")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DetachNetworkInterfaceInput{
+		AttachmentId: &attachmentID,
+	}
+
+	_, err = rm.sdkapi.DetachNetworkInterface(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DetachNetworkInterface", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateNetworkInterfaceInput.TagSpecification
+// and ensures the ResourceType is always set to 'network-interface'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateNetworkInterfaceInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "network-interface"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,131 @@
+package network_interface
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPreCompare(t *testing.T) {
+	createTestResource := func(secondaries []string, instanceID *string, deviceIndex *int64) *resource {
+		var addresses []*string
+		if secondaries != nil {
+			addresses = aws.StringSlice(secondaries)
+		}
+		return &resource{
+			ko: &svcapitypes.NetworkInterface{
+				Spec: svcapitypes.NetworkInterfaceSpec{
+					SecondaryPrivateIPAddresses: addresses,
+					InstanceID:                  instanceID,
+					DeviceIndex:                 deviceIndex,
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		id                 string
+		desiredAddresses   []string
+		latestAddresses    []string
+		desiredInstanceID  *string
+		desiredDeviceIndex *int64
+		latestDeviceIndex  *int64
+		toAdd              []string
+		toRemove           []string
+		deviceIndexDiffers bool
+	}{
+		{"identical",
+			[]string{"10.0.0.10"}, []string{"10.0.0.10"},
+			nil, nil, nil,
+			nil, nil, false,
+		},
+		{"different order",
+			[]string{"10.0.0.10", "10.0.0.11"}, []string{"10.0.0.11", "10.0.0.10"},
+			nil, nil, nil,
+			nil, nil, false,
+		},
+		{"replace address",
+			[]string{"10.0.0.12"}, []string{"10.0.0.10"},
+			nil, nil, nil,
+			[]string{"10.0.0.12"}, []string{"10.0.0.10"}, false,
+		},
+		{"unset addresses are unmanaged",
+			nil, []string{"10.0.0.10"},
+			nil, nil, nil,
+			nil, nil, false,
+		},
+		{"empty list removes all addresses",
+			[]string{}, []string{"10.0.0.10"},
+			nil, nil, nil,
+			nil, []string{"10.0.0.10"}, false,
+		},
+		{"device index ignored while detached",
+			nil, nil,
+			nil, aws.Int64(1), nil,
+			nil, nil, false,
+		},
+		{"device index changed",
+			nil, nil,
+			aws.String("i-1"), aws.Int64(2), aws.Int64(1),
+			nil, nil, true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := createTestResource(tc.desiredAddresses, tc.desiredInstanceID, tc.desiredDeviceIndex)
+			b := createTestResource(tc.latestAddresses, tc.desiredInstanceID, tc.latestDeviceIndex)
+
+			toAdd, toRemove := getStringsDifference(a.ko.Spec.SecondaryPrivateIPAddresses, b.ko.Spec.SecondaryPrivateIPAddresses)
+			assert.Equal(t, tc.toAdd, toAdd)
+			assert.Equal(t, tc.toRemove, toRemove)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, len(toAdd) > 0 || len(toRemove) > 0, delta.DifferentAt("Spec.SecondaryPrivateIPAddresses"))
+			assert.Equal(t, tc.deviceIndexDiffers, delta.DifferentAt("Spec.DeviceIndex"))
+		})
+	}
+}
+
+func TestCustomPreCompareUnsetLists(t *testing.T) {
+	desired := &resource{ko: &svcapitypes.NetworkInterface{}}
+	latest := &resource{ko: &svcapitypes.NetworkInterface{
+		Spec: svcapitypes.NetworkInterfaceSpec{
+			IPv4Prefixes:                aws.StringSlice([]string{"10.0.1.0/28"}),
+			IPv6Addresses:               aws.StringSlice([]string{"2600:1f14::1"}),
+			IPv6Prefixes:                aws.StringSlice([]string{"2600:1f14:0:1::/80"}),
+			SecondaryPrivateIPAddresses: aws.StringSlice([]string{"10.0.0.10"}),
+			SecurityGroupIDs:            aws.StringSlice([]string{"sg-default"}),
+		},
+	}}
+
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, desired, latest)
+	assert.Empty(t, delta.Differences)
+}
+
+func TestSetObservedFields(t *testing.T) {
+	ko := &svcapitypes.NetworkInterface{}
+	setObservedFields(ko, svcsdktypes.NetworkInterface{
+		Groups: []svcsdktypes.GroupIdentifier{{GroupId: aws.String("sg-1")}},
+		PrivateIpAddresses: []svcsdktypes.NetworkInterfacePrivateIpAddress{
+			{Primary: aws.Bool(true), PrivateIpAddress: aws.String("10.0.0.10")},
+			{Primary: aws.Bool(false), PrivateIpAddress: aws.String("10.0.0.11")},
+		},
+		Ipv4Prefixes:  []svcsdktypes.Ipv4PrefixSpecification{{Ipv4Prefix: aws.String("10.0.1.0/28")}},
+		Ipv6Addresses: []svcsdktypes.NetworkInterfaceIpv6Address{{Ipv6Address: aws.String("2001:db8::1")}},
+		TagSet:        []svcsdktypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	})
+
+	assert.Equal(t, []string{"sg-1"}, aws.StringValueSlice(ko.Spec.SecurityGroupIDs))
+	assert.Equal(t, []string{"10.0.0.11"}, aws.StringValueSlice(ko.Spec.SecondaryPrivateIPAddresses))
+	assert.Equal(t, []string{"10.0.1.0/28"}, aws.StringValueSlice(ko.Spec.IPv4Prefixes))
+	assert.Equal(t, []string{"2001:db8::1"}, aws.StringValueSlice(ko.Spec.IPv6Addresses))
+	assert.Nil(t, ko.Spec.IPv6Prefixes)
+	assert.Equal(t, "k", *ko.Spec.Tags[0].Key)
+}
@@ -0,0 +1,33 @@
+package placement_group
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlacementGroupState(t *testing.T) {
+	tt := []struct {
+		state   *string
+		deleted bool
+		pending bool
+	}{
+		{nil, false, false},
+		{aws.String("pending"), false, true},
+		{aws.String("available"), false, false},
+		{aws.String("deleting"), true, false},
+		{aws.String("deleted"), true, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(aws.StringValue(tc.state), func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.PlacementGroup{
+				Status: svcapitypes.PlacementGroupStatus{State: tc.state},
+			}}
+			assert.Equal(t, tc.deleted, isPlacementGroupDeleted(r))
+			assert.Equal(t, tc.pending, isPlacementGroupPending(r))
+		})
+	}
+}
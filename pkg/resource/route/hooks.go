@@ -0,0 +1,302 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package route
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/route_table"
+)
+
+// checkForMissingRequiredFields returns true if either the route table ID or
+// the destination identifying the route is not set.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Spec.RouteTableID == nil || getDestination(r.ko) == ""
+}
+
+// getDestination returns the destination CIDR block, IPv6 CIDR block or
+// prefix list ID of the route.
+func getDestination(ko *svcapitypes.Route) string {
+	switch {
+	case ko.Spec.DestinationCIDRBlock != nil:
+		return *ko.Spec.DestinationCIDRBlock
+	case ko.Spec.DestinationIPv6CIDRBlock != nil:
+		return *ko.Spec.DestinationIPv6CIDRBlock
+	default:
+		return aws.ToString(ko.Spec.DestinationPrefixListID)
+	}
+}
+
+// getOwner returns the value of the tag with which the resource claims its
+// destination on the route table.
+func getOwner(ko *svcapitypes.Route) string {
+	return types.NamespacedName{Namespace: ko.Namespace, Name: ko.Name}.String()
+}
+
+// findRoute returns the route of the route table to the destination of the
+// supplied resource, or nil if there is none.
+func findRoute(
+	routeTable svcsdktypes.RouteTable,
+	ko *svcapitypes.Route,
+) *svcsdktypes.Route {
+	for i, route := range routeTable.Routes {
+		if aws.ToString(route.DestinationCidrBlock) == aws.ToString(ko.Spec.DestinationCIDRBlock) &&
+			aws.ToString(route.DestinationIpv6CidrBlock) == aws.ToString(ko.Spec.DestinationIPv6CIDRBlock) &&
+			aws.ToString(route.DestinationPrefixListId) == aws.ToString(ko.Spec.DestinationPrefixListID) {
+			return &routeTable.Routes[i]
+		}
+	}
+	return nil
+}
+
+// setRouteTarget sets the target fields of ko from the supplied route.
+// Routes to a VPC endpoint are described with the endpoint as GatewayId, and
+// routes to an instance with both the instance and its network interface as
+// target, of which only the one set in ko is kept.
+func setRouteTarget(ko *svcapitypes.Route, route *svcsdktypes.Route) {
+	keepNetworkInterface := ko.Spec.InstanceID == nil && ko.Spec.NetworkInterfaceID != nil
+
+	ko.Spec.CarrierGatewayID = route.CarrierGatewayId
+	ko.Spec.CoreNetworkARN = route.CoreNetworkArn
+	ko.Spec.EgressOnlyInternetGatewayID = route.EgressOnlyInternetGatewayId
+	ko.Spec.GatewayID = route.GatewayId
+	ko.Spec.InstanceID = route.InstanceId
+	ko.Spec.LocalGatewayID = route.LocalGatewayId
+	ko.Spec.NATGatewayID = route.NatGatewayId
+	ko.Spec.NetworkInterfaceID = route.NetworkInterfaceId
+	ko.Spec.TransitGatewayID = route.TransitGatewayId
+	ko.Spec.VPCEndpointID = nil
+	ko.Spec.VPCPeeringConnectionID = route.VpcPeeringConnectionId
+
+	if route.GatewayId != nil && strings.HasPrefix(*route.GatewayId, "vpce-") {
+		ko.Spec.VPCEndpointID = route.GatewayId
+		ko.Spec.GatewayID = nil
+	}
+	if route.InstanceId != nil && route.NetworkInterfaceId != nil {
+		if keepNetworkInterface {
+			ko.Spec.InstanceID = nil
+		} else {
+			ko.Spec.NetworkInterfaceID = nil
+		}
+	}
+}
+
+// maxRouteTableTags is the maximum number of tags of a route table. The tags
+// with which Route resources claim their destinations count towards it.
+const maxRouteTableTags = 50
+
+type tagsClient interface {
+	CreateTags(context.Context, *svcsdk.CreateTagsInput, ...func(*svcsdk.Options)) (*svcsdk.CreateTagsOutput, error)
+	DescribeTags(context.Context, *svcsdk.DescribeTagsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeTagsOutput, error)
+}
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+// claimDestination tags the route table with the destination of the route, so
+// that the RouteTable resource managing the route table leaves the route in
+// place. A destination claimed by another Route resource is a terminal error.
+func (rm *resourceManager) claimDestination(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.claimDestination")
+	defer func() {
+		exit(err)
+	}()
+
+	return claimRouteTableDestination(
+		ctx, rm.sdkapi, rm.metrics,
+		*r.ko.Spec.RouteTableID, getDestination(r.ko), getOwner(r.ko),
+	)
+}
+
+// claimRouteTableDestination tags the route table with the owner of the
+// route to the destination. Tagging is not atomic: two Route resources may
+// claim the same destination at once, in which case the last tag written
+// wins. The tag is therefore read again once written, and the claim is
+// abandoned if it holds another owner. A route table that already has
+// maxRouteTableTags tags cannot be claimed, which is a terminal error.
+func claimRouteTableDestination(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	routeTableID string,
+	destination string,
+	owner string,
+) error {
+	key := route_table.GetRouteOwnerTagKey(destination)
+	tags, err := describeRouteTableTags(ctx, client, mr, routeTableID)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == key {
+			if aws.ToString(tag.Value) == owner {
+				return nil
+			}
+			return newDestinationOwnedError(destination, aws.ToString(tag.Value))
+		}
+	}
+	if len(tags) >= maxRouteTableTags {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"cannot claim the route to %s: route table %s already has the maximum of %d tags",
+			destination, routeTableID, maxRouteTableTags,
+		))
+	}
+
+	_, err = client.CreateTags(ctx, &svcsdk.CreateTagsInput{
+		Resources: []string{routeTableID},
+		Tags: []svcsdktypes.Tag{
+			{
+				Key:   aws.String(key),
+				Value: aws.String(owner),
+			},
+		},
+	})
+	mr.RecordAPICall("UPDATE", "CreateTags", err)
+	if err != nil {
+		return err
+	}
+
+	tags, err = describeRouteTableTags(ctx, client, mr, routeTableID)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == key && aws.ToString(tag.Value) != owner {
+			return newDestinationOwnedError(destination, aws.ToString(tag.Value))
+		}
+	}
+	return nil
+}
+
+// describeRouteTableTags returns all the tags of the route table.
+func describeRouteTableTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	routeTableID string,
+) ([]svcsdktypes.TagDescription, error) {
+	resp, err := client.DescribeTags(ctx, &svcsdk.DescribeTagsInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []string{routeTableID},
+			},
+		},
+	})
+	mr.RecordAPICall("READ_MANY", "DescribeTags", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tags, nil
+}
+
+// newDestinationOwnedError returns the terminal error reported when the
+// destination of the route is claimed by another Route resource.
+func newDestinationOwnedError(destination string, owner string) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"the route to %s is already owned by Route %s",
+		destination, owner,
+	))
+}
+
+// releaseDestination removes the tag with which the resource claims the
+// destination of the route. The tag of another Route resource is kept.
+func (rm *resourceManager) releaseDestination(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.releaseDestination")
+	defer func() {
+		exit(err)
+	}()
+
+	_, err = rm.sdkapi.DeleteTags(ctx, &svcsdk.DeleteTagsInput{
+		Resources: []string{*r.ko.Spec.RouteTableID},
+		Tags: []svcsdktypes.Tag{
+			{
+				Key:   aws.String(route_table.GetRouteOwnerTagKey(getDestination(r.ko))),
+				Value: aws.String(getOwner(r.ko)),
+			},
+		},
+	})
+	rm.metrics.RecordAPICall("DELETE", "DeleteTags", err)
+	return err
+}
+
+// customUpdateRoute replaces the target of the route. The destination and the
+// route table of a route are immutable.
+func (rm *resourceManager) customUpdateRoute(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateRoute")
+	defer func() {
+		exit(err)
+	}()
+
+	// The destination of a route that was adopted, or whose claim was
+	// removed from the route table, is claimed again.
+	if err = rm.claimDestination(ctx, desired); err != nil {
+		return nil, err
+	}
+
+	_, err = rm.sdkapi.ReplaceRoute(ctx, newReplaceRouteInput(desired))
+	rm.metrics.RecordAPICall("UPDATE", "ReplaceRoute", err)
+	if err != nil {
+		return nil, err
+	}
+	return rm.concreteResource(desired.DeepCopy()), nil
+}
+
+// newReplaceRouteInput returns the ReplaceRoute input setting the target of
+// the route to the one of the supplied resource.
+func newReplaceRouteInput(r *resource) *svcsdk.ReplaceRouteInput {
+	return &svcsdk.ReplaceRouteInput{
+		RouteTableId:                r.ko.Spec.RouteTableID,
+		CarrierGatewayId:            r.ko.Spec.CarrierGatewayID,
+		CoreNetworkArn:              r.ko.Spec.CoreNetworkARN,
+		DestinationCidrBlock:        r.ko.Spec.DestinationCIDRBlock,
+		DestinationIpv6CidrBlock:    r.ko.Spec.DestinationIPv6CIDRBlock,
+		DestinationPrefixListId:     r.ko.Spec.DestinationPrefixListID,
+		EgressOnlyInternetGatewayId: r.ko.Spec.EgressOnlyInternetGatewayID,
+		GatewayId:                   r.ko.Spec.GatewayID,
+		InstanceId:                  r.ko.Spec.InstanceID,
+		LocalGatewayId:              r.ko.Spec.LocalGatewayID,
+		NatGatewayId:                r.ko.Spec.NATGatewayID,
+		NetworkInterfaceId:          r.ko.Spec.NetworkInterfaceID,
+		TransitGatewayId:            r.ko.Spec.TransitGatewayID,
+		VpcEndpointId:               r.ko.Spec.VPCEndpointID,
+		VpcPeeringConnectionId:      r.ko.Spec.VPCPeeringConnectionID,
+	}
+}
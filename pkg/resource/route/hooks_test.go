@@ -0,0 +1,177 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/route_table"
+)
+
+func TestFindRoute(t *testing.T) {
+	routeTable := svcsdktypes.RouteTable{
+		Routes: []svcsdktypes.Route{
+			{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+			{DestinationIpv6CidrBlock: aws.String("::/0"), EgressOnlyInternetGatewayId: aws.String("eigw-1")},
+			{DestinationPrefixListId: aws.String("pl-1"), GatewayId: aws.String("vpce-1")},
+		},
+	}
+
+	tt := []struct {
+		id    string
+		spec  svcapitypes.RouteSpec
+		found bool
+	}{
+		{"cidr block", svcapitypes.RouteSpec{DestinationCIDRBlock: aws.String("10.0.0.0/16")}, true},
+		{"ipv6 cidr block", svcapitypes.RouteSpec{DestinationIPv6CIDRBlock: aws.String("::/0")}, true},
+		{"prefix list", svcapitypes.RouteSpec{DestinationPrefixListID: aws.String("pl-1")}, true},
+		{"missing route", svcapitypes.RouteSpec{DestinationCIDRBlock: aws.String("0.0.0.0/0")}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			ko := &svcapitypes.Route{Spec: tc.spec}
+			route := findRoute(routeTable, ko)
+			assert.Equal(t, tc.found, route != nil)
+			if tc.found {
+				assert.Equal(t, getDestination(ko), aws.StringValue(route.DestinationCidrBlock)+
+					aws.StringValue(route.DestinationIpv6CidrBlock)+aws.StringValue(route.DestinationPrefixListId))
+			}
+		})
+	}
+}
+
+func TestSetRouteTarget(t *testing.T) {
+	instanceRoute := &svcsdktypes.Route{
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		InstanceId:           aws.String("i-1"),
+		NetworkInterfaceId:   aws.String("eni-1"),
+	}
+
+	tt := []struct {
+		id       string
+		spec     svcapitypes.RouteSpec
+		route    *svcsdktypes.Route
+		expected svcapitypes.RouteSpec
+	}{
+		{"vpc endpoint",
+			svcapitypes.RouteSpec{VPCEndpointID: aws.String("vpce-1")},
+			&svcsdktypes.Route{GatewayId: aws.String("vpce-1")},
+			svcapitypes.RouteSpec{VPCEndpointID: aws.String("vpce-1")},
+		},
+		{"instance",
+			svcapitypes.RouteSpec{InstanceID: aws.String("i-1")},
+			instanceRoute,
+			svcapitypes.RouteSpec{InstanceID: aws.String("i-1")},
+		},
+		{"network interface of an instance",
+			svcapitypes.RouteSpec{NetworkInterfaceID: aws.String("eni-1")},
+			instanceRoute,
+			svcapitypes.RouteSpec{NetworkInterfaceID: aws.String("eni-1")},
+		},
+		{"changed target",
+			svcapitypes.RouteSpec{NATGatewayID: aws.String("nat-1")},
+			&svcsdktypes.Route{VpcPeeringConnectionId: aws.String("pcx-1")},
+			svcapitypes.RouteSpec{VPCPeeringConnectionID: aws.String("pcx-1")},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			ko := &svcapitypes.Route{Spec: tc.spec}
+			setRouteTarget(ko, tc.route)
+			assert.Equal(t, tc.expected, ko.Spec)
+		})
+	}
+}
+
+type fakeTagsClient struct {
+	tags map[string]string
+	// onCreateTags is called after the tags are created, to simulate another
+	// Route resource claiming the same destination at once.
+	onCreateTags func(tags map[string]string)
+	created      int
+}
+
+func (c *fakeTagsClient) CreateTags(
+	_ context.Context,
+	input *svcsdk.CreateTagsInput,
+	_ ...func(*svcsdk.Options),
+) (*svcsdk.CreateTagsOutput, error) {
+	c.created++
+	for _, tag := range input.Tags {
+		c.tags[*tag.Key] = *tag.Value
+	}
+	if c.onCreateTags != nil {
+		c.onCreateTags(c.tags)
+	}
+	return &svcsdk.CreateTagsOutput{}, nil
+}
+
+func (c *fakeTagsClient) DescribeTags(
+	_ context.Context,
+	_ *svcsdk.DescribeTagsInput,
+	_ ...func(*svcsdk.Options),
+) (*svcsdk.DescribeTagsOutput, error) {
+	resp := &svcsdk.DescribeTagsOutput{}
+	for key, value := range c.tags {
+		resp.Tags = append(resp.Tags, svcsdktypes.TagDescription{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return resp, nil
+}
+
+type fakeMetricsRecorder struct{}
+
+func (fakeMetricsRecorder) RecordAPICall(string, string, error) {}
+
+func TestClaimRouteTableDestination(t *testing.T) {
+	key := route_table.GetRouteOwnerTagKey("10.0.0.0/16")
+	manyTags := func() map[string]string {
+		tags := map[string]string{}
+		for i := 0; i < maxRouteTableTags; i++ {
+			tags[fmt.Sprintf("tag-%d", i)] = "value"
+		}
+		return tags
+	}
+
+	tt := []struct {
+		id           string
+		tags         map[string]string
+		onCreateTags func(tags map[string]string)
+		terminal     bool
+		created      int
+	}{
+		{"unclaimed destination", map[string]string{}, nil, false, 1},
+		{"claimed by this route", map[string]string{key: "default/route"}, nil, false, 0},
+		{"claimed by another route", map[string]string{key: "default/other"}, nil, true, 0},
+		{"claimed by another route at once",
+			map[string]string{},
+			func(tags map[string]string) { tags[key] = "default/other" },
+			true, 1,
+		},
+		{"tag limit reached", manyTags(), nil, true, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			client := &fakeTagsClient{tags: tc.tags, onCreateTags: tc.onCreateTags}
+			err := claimRouteTableDestination(
+				context.TODO(), client, fakeMetricsRecorder{},
+				"rtb-1", "10.0.0.0/16", "default/route",
+			)
+			if tc.terminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, "default/route", client.tags[key])
+			}
+			assert.Equal(t, tc.created, client.created)
+		})
+	}
+}
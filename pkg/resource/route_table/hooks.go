@@ -15,19 +15,85 @@ package route_table
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
 	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const LocalRouteGateway = "local"
 
+const (
+	// ConditionTypeBlackholeRoutes is set on a RouteTable that has routes
+	// whose target no longer exists. Its message lists their destinations.
+	ConditionTypeBlackholeRoutes ackv1alpha1.ConditionType = "BlackholeRoutes"
+
+	// BlackholeRoutePolicyReport only reports blackhole routes through the
+	// BlackholeRoutes condition. It is the default.
+	BlackholeRoutePolicyReport = "Report"
+	// BlackholeRoutePolicyRecreate keeps requeueing a RouteTable with
+	// blackhole routes whose target is set through a reference, so that the
+	// routes are recreated as soon as the referenced resource has a new ID.
+	BlackholeRoutePolicyRecreate = "Recreate"
+
+	// UnmanagedRoutePolicyAuthoritative deletes the routes that are not
+	// declared in Spec.Routes, unless their destination is listed in
+	// Spec.IgnoredRouteDestinations. It is the default.
+	UnmanagedRoutePolicyAuthoritative = "Authoritative"
+	// UnmanagedRoutePolicyAdditive only ensures the routes declared in
+	// Spec.Routes exist, other routes are left in place.
+	UnmanagedRoutePolicyAdditive = "Additive"
+
+	// RouteOwnerTagKeyPrefix prefixes the key of the tags with which Route
+	// resources claim a destination of the route table they add a route to.
+	// The key ends with the destination and the value is the namespace and
+	// name of the Route resource. These tags are not reported in Spec.Tags,
+	// and the routes they claim are never synced by the RouteTable.
+	RouteOwnerTagKeyPrefix = "ec2.services.k8s.aws/route:"
+)
+
+// GetRouteOwnerTagKey returns the key of the tag with which a Route resource
+// claims the destination on its route table.
+func GetRouteOwnerTagKey(destination string) string {
+	return RouteOwnerTagKeyPrefix + destination
+}
+
+// NewResourceManager returns a manager for RouteTable resources in the given
+// account and region. The DefaultRouteTable resource uses it to manage the main
+// route table of a VPC like any other route table.
+func NewResourceManager(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) (acktypes.AWSResourceManager, error) {
+	return newResourceManager(cfg, clientcfg, log, metrics, rr, id, region)
+}
+
+// NewResourceDescriptor returns the descriptor of RouteTable resources
+func NewResourceDescriptor() acktypes.AWSResourceDescriptor {
+	return &resourceDescriptor{}
+}
+
 func (rm *resourceManager) createRoutes(
 	ctx context.Context,
 	r *resource,
@@ -49,6 +115,11 @@ func (rm *resourceManager) syncRoutes(
 
 	if latest != nil {
 		latest.ko.Spec.Routes = removeLocalRoute(latest.ko.Spec.Routes)
+		latest.ko.Spec.Routes = removePropagatedRoutes(latest.ko.Spec.Routes, latest.ko.Status.RouteStatuses)
+		if desired != nil {
+			latest.ko.Spec.Routes = removeUnmanagedRoutes(latest.ko.Spec.Routes, desired.ko)
+			latest.ko.Spec.Routes = removeOwnedRoutes(latest.ko.Spec.Routes, desired.ko, latest.ko.Status.RouteOwners)
+		}
 		latest.ko.Spec.Routes, err = rm.excludeAWSRoute(ctx, latest.ko.Spec.Routes)
 		if err != nil {
 			return err
@@ -73,21 +144,38 @@ func (rm *resourceManager) syncRoutes(
 		toAdd = desired.ko.Spec.Routes
 	}
 
-	// Delete and add the routes that were found to be different between desired
-	// and latest.
+	// Routes whose target changed are replaced in place, so that the traffic
+	// to their destination is never dropped.
+	toReplace, toAdd, toDelete := getRoutesToReplace(toAdd, toDelete)
+
+	// Delete, replace and add the routes that were found to be different
+	// between desired and latest. A route that fails does not prevent the
+	// others from being synced, the error is recorded for its destination.
+	failures := map[string]*string{}
 	for _, route := range toDelete {
 		rlog.Debug("deleting route from route table")
-		if err = rm.deleteRoute(ctx, latest, *route); err != nil {
-			return err
+		if err := rm.deleteRoute(ctx, latest, *route); err != nil {
+			failures[getRouteDestination(route)] = aws.String(err.Error())
+		}
+	}
+	for _, route := range toReplace {
+		rlog.Debug("replacing route in route table")
+		if err := rm.replaceRoute(ctx, desired, *route); err != nil {
+			failures[getRouteDestination(route)] = aws.String(err.Error())
 		}
 	}
 	for _, route := range toAdd {
 		rlog.Debug("adding route to route table")
-		if err = rm.createRoute(ctx, desired, *route); err != nil {
-			return err
+		if err := rm.createRoute(ctx, desired, *route); err != nil {
+			failures[getRouteDestination(route)] = aws.String(err.Error())
 		}
 	}
 
+	if len(failures) > 0 {
+		desired.ko.Status.RouteFailures = failures
+		return fmt.Errorf("failed to sync %d route(s), see Status.RouteFailures", len(failures))
+	}
+	desired.ko.Status.RouteFailures = nil
 	return nil
 }
 
@@ -109,6 +197,24 @@ func (rm *resourceManager) createRoute(
 	return err
 }
 
+func (rm *resourceManager) replaceRoute(
+	ctx context.Context,
+	r *resource,
+	c svcapitypes.CreateRouteInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.replaceRoute")
+	defer func(err error) { exit(err) }(err)
+
+	input := rm.newReplaceRouteInput(c)
+	// Routes should only be configurable for the
+	// RouteTable in which they are defined
+	input.RouteTableId = r.ko.Status.RouteTableID
+	_, err = rm.sdkapi.ReplaceRoute(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ReplaceRoute", err)
+	return err
+}
+
 func (rm *resourceManager) deleteRoute(
 	ctx context.Context,
 	r *resource,
@@ -154,23 +260,65 @@ func (rm *resourceManager) customUpdateRouteTable(
 		}
 	}
 
-	if delta.DifferentAt("Spec.Routes") {
-		if err := rm.syncRoutes(ctx, desired, latest); err != nil {
+	if delta.DifferentAt("Spec.PropagatingVPNGateways") {
+		if err := rm.syncVGWRoutePropagations(ctx, desired, latest); err != nil {
 			return nil, err
 		}
+	}
+
+	if delta.DifferentAt("Spec.Routes") {
+		syncErr := rm.syncRoutes(ctx, desired, latest)
 		// A ReadOne call is made to refresh Status.RouteStatuses
 		// with the recently-updated data from the above `sync` call
 		updated, err = rm.sdkFind(ctx, desired)
 		if err != nil {
 			return nil, err
 		}
+		if syncErr != nil {
+			// The resource is returned along with the error, so that
+			// Status.RouteFailures is saved.
+			newDesired := rm.concreteResource(desired.DeepCopy())
+			newDesired.ko.Status = updated.ko.Status
+			return newDesired, syncErr
+		}
+	}
+
+	if delta.DifferentAt("Spec.GatewayID") || delta.DifferentAt("Spec.VPNGatewayID") {
+		if err := rm.syncGatewayAssociation(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// A ReadOne call is made to refresh Status.Associations with the
+		// state of the gateway association
+		updated, err = rm.sdkFind(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Main") {
+		if err := rm.syncMainRouteTable(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// A ReadOne call is made to refresh Status.Associations with the
+		// replaced main route table association
+		updated, err = rm.sdkFind(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	newDesired := rm.concreteResource(desired.DeepCopy())
 	newDesired.ko.Status = updated.ko.Status
+	setBlackholeRoutesCondition(newDesired, desired.ko.Spec.Routes)
 	return newDesired, nil
 }
 
+// checkForMissingRequiredFields returns true if the route table has not been
+// created yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.RouteTableID == nil
+}
+
 func (rm *resourceManager) requiredFieldsMissingForCreateRoute(
 	r *resource,
 ) bool {
@@ -187,7 +335,7 @@ func (rm *resourceManager) addRoutesToStatus(
 ) {
 	ko.Status.RouteStatuses = nil
 	if routeTable.Routes != nil {
-		routesInStatus := []*svcapitypes.Route{}
+		routesInStatus := []*svcapitypes.Route_SDK{}
 		for _, r := range routeTable.Routes {
 			routesInStatus = append(routesInStatus, rm.setResourceRoute(r))
 		}
@@ -209,12 +357,45 @@ func customPreCompare(
 ) {
 	a.ko.Spec.Routes = removeLocalRoute(a.ko.Spec.Routes)
 	b.ko.Spec.Routes = removeLocalRoute(b.ko.Spec.Routes)
+	// Routes propagated by a virtual private gateway are not managed through
+	// Spec.Routes and must never be deleted by syncRoutes.
+	b.ko.Spec.Routes = removePropagatedRoutes(b.ko.Spec.Routes, b.ko.Status.RouteStatuses)
+	// Neither must the routes left in place by the unmanaged route policy,
+	// nor the routes owned by Route resources.
+	b.ko.Spec.Routes = removeUnmanagedRoutes(b.ko.Spec.Routes, a.ko)
+	b.ko.Spec.Routes = removeOwnedRoutes(b.ko.Spec.Routes, a.ko, b.ko.Status.RouteOwners)
 
 	desired, latest := getRoutesDifference(a.ko.Spec.Routes, b.ko.Spec.Routes)
 
 	if len(desired) > 0 || len(latest) > 0 {
 		delta.Add("Spec.Routes", a.ko.Spec.Routes, b.ko.Spec.Routes)
 	}
+
+	toEnable, toDisable := getVPNGatewayIDsDifference(a.ko.Spec.PropagatingVPNGateways, b.ko.Spec.PropagatingVPNGateways)
+	if len(toEnable) > 0 || len(toDisable) > 0 {
+		delta.Add("Spec.PropagatingVPNGateways", a.ko.Spec.PropagatingVPNGateways, b.ko.Spec.PropagatingVPNGateways)
+	}
+
+	// An unset Spec.Main is the same as false. A route table that was made
+	// main outside of the controller is left main, as there is no original
+	// main route table to restore.
+	if aws.ToBool(a.ko.Spec.Main) != aws.ToBool(b.ko.Spec.Main) &&
+		(aws.ToBool(a.ko.Spec.Main) || b.ko.Status.OriginalMainRouteTableID != nil) {
+		delta.Add("Spec.Main", a.ko.Spec.Main, b.ko.Spec.Main)
+	}
+
+	// The gateway of the edge association is compared regardless of the
+	// field it is set in, and only when the association is managed by the
+	// route table.
+	if managesGatewayAssociation(a.ko) &&
+		aws.ToString(getEdgeGatewayID(a.ko)) != aws.ToString(getEdgeGatewayID(b.ko)) {
+		if aws.ToString(a.ko.Spec.GatewayID) != aws.ToString(b.ko.Spec.GatewayID) {
+			delta.Add("Spec.GatewayID", a.ko.Spec.GatewayID, b.ko.Spec.GatewayID)
+		}
+		if aws.ToString(a.ko.Spec.VPNGatewayID) != aws.ToString(b.ko.Spec.VPNGatewayID) {
+			delta.Add("Spec.VPNGatewayID", a.ko.Spec.VPNGatewayID, b.ko.Spec.VPNGatewayID)
+		}
+	}
 }
 
 // getRoutesDifference compares the desired and latest routes. It returns the
@@ -251,6 +432,187 @@ func getRoutesDifference(desired, latest []*svcapitypes.CreateRouteInput) (toAdd
 	return toAdd, toDelete
 }
 
+// getRouteDestination returns the destination of a route, which identifies the
+// route within its route table.
+func getRouteDestination(route *svcapitypes.CreateRouteInput) string {
+	switch {
+	case route.DestinationCIDRBlock != nil:
+		return *route.DestinationCIDRBlock
+	case route.DestinationIPv6CIDRBlock != nil:
+		return *route.DestinationIPv6CIDRBlock
+	default:
+		return aws.ToString(route.DestinationPrefixListID)
+	}
+}
+
+// getBlackholeDestinations returns the destinations of the routes whose target
+// no longer exists.
+func getBlackholeDestinations(routeStatuses []*svcapitypes.Route_SDK) []string {
+	var destinations []string
+	for _, route := range routeStatuses {
+		if aws.ToString(route.State) != string(svcsdktypes.RouteStateBlackhole) {
+			continue
+		}
+		destinations = append(destinations, getRouteStatusDestination(route))
+	}
+	return destinations
+}
+
+// hasTargetReference returns true if the target of the route is set through a
+// reference to another resource.
+func hasTargetReference(route *svcapitypes.CreateRouteInput) bool {
+	return route.EgressOnlyInternetGatewayRef != nil ||
+		route.GatewayRef != nil ||
+		route.InstanceRef != nil ||
+		route.NATGatewayRef != nil ||
+		route.NetworkInterfaceRef != nil ||
+		route.TransitGatewayRef != nil ||
+		route.VPCEndpointRef != nil ||
+		route.VPCPeeringConnectionRef != nil
+}
+
+// setBlackholeRoutesCondition sets the BlackholeRoutes condition when the
+// route table has blackhole routes, and removes it otherwise. With the Recreate policy, the resource is
+// also marked as not synced while a blackhole route has a referenced target,
+// so that it is requeued until the route can be recreated with the new ID of
+// the referenced resource.
+func setBlackholeRoutesCondition(
+	r *resource,
+	desiredRoutes []*svcapitypes.CreateRouteInput,
+) {
+	destinations := getBlackholeDestinations(r.ko.Status.RouteStatuses)
+	if len(destinations) == 0 {
+		// The blackhole routes were fixed or removed.
+		r.ko.Status.Conditions = lo.Reject(r.ko.Status.Conditions, func(c *ackv1alpha1.Condition, _ int) bool {
+			return c.Type == ConditionTypeBlackholeRoutes
+		})
+		return
+	}
+
+	message := fmt.Sprintf(
+		"the target of the routes to %s no longer exists",
+		strings.Join(destinations, ", "),
+	)
+	var cond *ackv1alpha1.Condition
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ConditionTypeBlackholeRoutes {
+			cond = c
+		}
+	}
+	if cond == nil {
+		cond = &ackv1alpha1.Condition{Type: ConditionTypeBlackholeRoutes}
+		r.ko.Status.Conditions = append(r.ko.Status.Conditions, cond)
+	}
+	// The condition is left untouched while the blackhole routes are the
+	// same, so that its LastTransitionTime is kept.
+	if cond.Status != corev1.ConditionTrue || aws.ToString(cond.Message) != message {
+		now := metav1.Now()
+		cond.LastTransitionTime = &now
+		cond.Status = corev1.ConditionTrue
+		cond.Message = &message
+	}
+
+	if aws.ToString(r.ko.Spec.BlackholeRoutePolicy) != BlackholeRoutePolicyRecreate {
+		return
+	}
+	referenced := lo.Filter(destinations, func(dest string, _ int) bool {
+		return lo.ContainsBy(desiredRoutes, func(route *svcapitypes.CreateRouteInput) bool {
+			return getRouteDestination(route) == dest && hasTargetReference(route)
+		})
+	})
+	if len(referenced) > 0 {
+		ackcondition.SetSynced(r, corev1.ConditionFalse, aws.String(fmt.Sprintf(
+			"waiting for the referenced target of the routes to %s to be recreated",
+			strings.Join(referenced, ", "),
+		)), nil)
+	}
+}
+
+// normalizeInstanceRoutes drops the target of the latest routes to an instance
+// that is not set in the desired route with the same destination. Such routes
+// are described with both InstanceID and NetworkInterfaceID. InstanceID is
+// kept when there is no desired route for the destination.
+func normalizeInstanceRoutes(
+	desired []*svcapitypes.CreateRouteInput,
+	latest []*svcapitypes.CreateRouteInput,
+) {
+	for _, route := range latest {
+		if route.InstanceID == nil || route.NetworkInterfaceID == nil {
+			continue
+		}
+		desiredRoute, found := lo.Find(desired, func(r *svcapitypes.CreateRouteInput) bool {
+			return getRouteDestination(r) == getRouteDestination(route)
+		})
+		if found && desiredRoute.InstanceID == nil && desiredRoute.NetworkInterfaceID != nil {
+			route.InstanceID = nil
+		} else {
+			route.NetworkInterfaceID = nil
+		}
+	}
+}
+
+// getRouteStatusDestination returns the destination of a route described in
+// Status.RouteStatuses.
+func getRouteStatusDestination(status *svcapitypes.Route_SDK) string {
+	return getRouteDestination(&svcapitypes.CreateRouteInput{
+		DestinationCIDRBlock:     status.DestinationCIDRBlock,
+		DestinationIPv6CIDRBlock: status.DestinationIPv6CIDRBlock,
+		DestinationPrefixListID:  status.DestinationPrefixListID,
+	})
+}
+
+// getRoutesToReplace returns the routes to add that have the same destination
+// as a route to delete. Their target is replaced with ReplaceRoute instead of
+// deleting and creating the route again. The remaining routes to add and to
+// delete are returned along with them.
+func getRoutesToReplace(
+	toAdd []*svcapitypes.CreateRouteInput,
+	toDelete []*svcapitypes.CreateRouteInput,
+) (toReplace, remainingToAdd, remainingToDelete []*svcapitypes.CreateRouteInput) {
+	remainingToDelete = make([]*svcapitypes.CreateRouteInput, len(toDelete))
+	copy(remainingToDelete, toDelete)
+	for _, route := range toAdd {
+		idx := lo.IndexOf(
+			lo.Map(remainingToDelete, func(r *svcapitypes.CreateRouteInput, _ int) string {
+				return getRouteDestination(r)
+			}),
+			getRouteDestination(route),
+		)
+		if idx < 0 {
+			remainingToAdd = append(remainingToAdd, route)
+			continue
+		}
+		toReplace = append(toReplace, route)
+		remainingToDelete = append(remainingToDelete[:idx], remainingToDelete[idx+1:]...)
+	}
+	return toReplace, remainingToAdd, remainingToDelete
+}
+
+// getRemainingRouteFailures returns the route failures for the destinations
+// whose routes still differ between desired and latest. The failures of routes
+// that have been synced since are dropped.
+func getRemainingRouteFailures(
+	failures map[string]*string,
+	desired []*svcapitypes.CreateRouteInput,
+	latest []*svcapitypes.CreateRouteInput,
+) map[string]*string {
+	if len(failures) == 0 {
+		return nil
+	}
+	toAdd, toDelete := getRoutesDifference(removeLocalRoute(desired), removeLocalRoute(latest))
+	remaining := map[string]*string{}
+	for _, route := range append(toAdd, toDelete...) {
+		dest := getRouteDestination(route)
+		if failure, ok := failures[dest]; ok {
+			remaining[dest] = failure
+		}
+	}
+	if len(remaining) == 0 {
+		return nil
+	}
+	return remaining
+}
+
 // removeLocalRoute will filter out any routes that have a gateway ID that
 // matches the local gateway. Every route table contains a local route for
 // communication within the VPC, which cannot be deleted or modified, and should
@@ -269,6 +631,266 @@ func removeLocalRoute(
 	return ret
 }
 
+// removePropagatedRoutes will filter out any routes that were propagated to
+// the route table by a virtual private gateway (origin
+// EnableVgwRoutePropagation). Propagated routes are controlled through
+// Spec.PropagatingVPNGateways and cannot be deleted with DeleteRoute.
+func removePropagatedRoutes(
+	routes []*svcapitypes.CreateRouteInput,
+	routeStatuses []*svcapitypes.Route_SDK,
+) []*svcapitypes.CreateRouteInput {
+	propagated := lo.Filter(routeStatuses, func(status *svcapitypes.Route_SDK, _ int) bool {
+		return status.Origin != nil &&
+			*status.Origin == string(svcsdktypes.RouteOriginEnableVgwRoutePropagation)
+	})
+	if len(propagated) == 0 {
+		return routes
+	}
+
+	return lo.Reject(routes, func(route *svcapitypes.CreateRouteInput, _ int) bool {
+		return lo.ContainsBy(propagated, func(status *svcapitypes.Route_SDK) bool {
+			return aws.ToString(route.DestinationCIDRBlock) == aws.ToString(status.DestinationCIDRBlock) &&
+				aws.ToString(route.DestinationIPv6CIDRBlock) == aws.ToString(status.DestinationIPv6CIDRBlock) &&
+				aws.ToString(route.DestinationPrefixListID) == aws.ToString(status.DestinationPrefixListID)
+		})
+	})
+}
+
+// isDeclaredRouteDestination returns true if a route to the destination is
+// declared in Spec.Routes of the supplied resource.
+func isDeclaredRouteDestination(
+	ko *svcapitypes.RouteTable,
+	destination string,
+) bool {
+	return lo.ContainsBy(ko.Spec.Routes, func(route *svcapitypes.CreateRouteInput) bool {
+		return getRouteDestination(route) == destination
+	})
+}
+
+// isUnmanagedRouteDestination returns true if a route to the destination must
+// be left in place by the controller although it is not declared in the
+// supplied resource, according to its unmanaged route policy.
+func isUnmanagedRouteDestination(
+	ko *svcapitypes.RouteTable,
+	destination string,
+) bool {
+	if isDeclaredRouteDestination(ko, destination) {
+		return false
+	}
+	if aws.ToString(ko.Spec.UnmanagedRoutePolicy) == UnmanagedRoutePolicyAdditive {
+		return true
+	}
+	return lo.Contains(aws.ToStringSlice(ko.Spec.IgnoredRouteDestinations), destination)
+}
+
+// removeUnmanagedRoutes will filter out the routes that the unmanaged route
+// policy of the desired resource leaves in place, so that they are never
+// deleted by syncRoutes.
+func removeUnmanagedRoutes(
+	routes []*svcapitypes.CreateRouteInput,
+	desired *svcapitypes.RouteTable,
+) []*svcapitypes.CreateRouteInput {
+	return lo.Reject(routes, func(route *svcapitypes.CreateRouteInput, _ int) bool {
+		return isUnmanagedRouteDestination(desired, getRouteDestination(route))
+	})
+}
+
+// removeOwnedRoutes will filter out the routes owned by Route resources, so
+// that they are never deleted by syncRoutes. A route that is also declared in
+// the desired resource is synced by the RouteTable.
+func removeOwnedRoutes(
+	routes []*svcapitypes.CreateRouteInput,
+	desired *svcapitypes.RouteTable,
+	routeOwners map[string]*string,
+) []*svcapitypes.CreateRouteInput {
+	if len(routeOwners) == 0 {
+		return routes
+	}
+	return lo.Reject(routes, func(route *svcapitypes.CreateRouteInput, _ int) bool {
+		destination := getRouteDestination(route)
+		_, owned := routeOwners[destination]
+		return owned && !isDeclaredRouteDestination(desired, destination)
+	})
+}
+
+// getRouteOwners splits the tags of a route table into the tags reported in
+// Spec.Tags and the Route resources owning its routes, keyed by the
+// destination of their route.
+func getRouteOwners(
+	tags []*svcapitypes.Tag,
+) ([]*svcapitypes.Tag, map[string]*string) {
+	routeOwners := map[string]*string{}
+	tags = lo.Reject(tags, func(tag *svcapitypes.Tag, _ int) bool {
+		destination, found := strings.CutPrefix(aws.ToString(tag.Key), RouteOwnerTagKeyPrefix)
+		if found {
+			routeOwners[destination] = tag.Value
+		}
+		return found
+	})
+	if len(tags) == 0 {
+		tags = nil
+	}
+	if len(routeOwners) == 0 {
+		routeOwners = nil
+	}
+	return tags, routeOwners
+}
+
+// getUnmanagedRoutes returns the routes created outside of the controller that
+// the unmanaged route policy of the resource leaves in place. The local route,
+// the routes propagated by virtual private gateways and the routes owned by
+// Route resources are not included.
+func getUnmanagedRoutes(
+	ko *svcapitypes.RouteTable,
+	routeStatuses []*svcapitypes.Route_SDK,
+	routeOwners map[string]*string,
+) []*svcapitypes.Route_SDK {
+	unmanaged := lo.Filter(routeStatuses, func(status *svcapitypes.Route_SDK, _ int) bool {
+		if aws.ToString(status.Origin) != string(svcsdktypes.RouteOriginCreateRoute) {
+			return false
+		}
+		destination := getRouteStatusDestination(status)
+		if _, owned := routeOwners[destination]; owned {
+			return false
+		}
+		return isUnmanagedRouteDestination(ko, destination)
+	})
+	if len(unmanaged) == 0 {
+		return nil
+	}
+	return unmanaged
+}
+
+// getPropagatingVPNGatewayIDs returns the IDs of the virtual private gateways
+// propagating routes to the route table.
+func getPropagatingVPNGatewayIDs(
+	propagatingVGWs []*svcapitypes.PropagatingVGW,
+) []*string {
+	if len(propagatingVGWs) == 0 {
+		return nil
+	}
+	ids := make([]*string, 0, len(propagatingVGWs))
+	for _, vgw := range propagatingVGWs {
+		if vgw.GatewayID != nil {
+			ids = append(ids, vgw.GatewayID)
+		}
+	}
+	return ids
+}
+
+// getVPNGatewayIDsDifference returns the VPN gateway IDs that are desired but
+// not present in latest, and those present in latest but no longer desired.
+func getVPNGatewayIDsDifference(
+	desired []*string,
+	latest []*string,
+) (toEnable []string, toDisable []string) {
+	desiredSet := map[string]struct{}{}
+	for _, id := range desired {
+		if id != nil {
+			desiredSet[*id] = struct{}{}
+		}
+	}
+	latestSet := map[string]struct{}{}
+	for _, id := range latest {
+		if id != nil {
+			latestSet[*id] = struct{}{}
+		}
+	}
+	for _, id := range desired {
+		if id == nil {
+			continue
+		}
+		if _, ok := latestSet[*id]; !ok {
+			toEnable = append(toEnable, *id)
+			latestSet[*id] = struct{}{}
+		}
+	}
+	for _, id := range latest {
+		if id == nil {
+			continue
+		}
+		if _, ok := desiredSet[*id]; !ok {
+			toDisable = append(toDisable, *id)
+			desiredSet[*id] = struct{}{}
+		}
+	}
+	return toEnable, toDisable
+}
+
+// syncVGWRoutePropagations enables and disables the propagation of routes
+// from virtual private gateways to the route table, so that it matches
+// Spec.PropagatingVPNGateways. latest is nil when called right after the
+// route table was created.
+func (rm *resourceManager) syncVGWRoutePropagations(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncVGWRoutePropagations")
+	defer func(err error) { exit(err) }(err)
+
+	var toEnable, toDisable []string
+	if latest != nil {
+		toEnable, toDisable = getVPNGatewayIDsDifference(
+			desired.ko.Spec.PropagatingVPNGateways, latest.ko.Spec.PropagatingVPNGateways,
+		)
+	} else {
+		toEnable, _ = getVPNGatewayIDsDifference(desired.ko.Spec.PropagatingVPNGateways, nil)
+	}
+
+	for _, vgwID := range toDisable {
+		rlog.Debug("disabling route propagation from VPN gateway", "vpn_gateway_id", vgwID)
+		if err = rm.disableVGWRoutePropagation(ctx, desired, vgwID); err != nil {
+			return err
+		}
+	}
+	for _, vgwID := range toEnable {
+		rlog.Debug("enabling route propagation from VPN gateway", "vpn_gateway_id", vgwID)
+		if err = rm.enableVGWRoutePropagation(ctx, desired, vgwID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) enableVGWRoutePropagation(
+	ctx context.Context,
+	r *resource,
+	vgwID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.enableVGWRoutePropagation")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.EnableVgwRoutePropagationInput{
+		GatewayId:    &vgwID,
+		RouteTableId: r.ko.Status.RouteTableID,
+	}
+	_, err = rm.sdkapi.EnableVgwRoutePropagation(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "EnableVgwRoutePropagation", err)
+	return err
+}
+
+func (rm *resourceManager) disableVGWRoutePropagation(
+	ctx context.Context,
+	r *resource,
+	vgwID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disableVGWRoutePropagation")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.DisableVgwRoutePropagationInput{
+		GatewayId:    &vgwID,
+		RouteTableId: r.ko.Status.RouteTableID,
+	}
+	_, err = rm.sdkapi.DisableVgwRoutePropagation(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DisableVgwRoutePropagation", err)
+	return err
+}
+
 func (rm *resourceManager) excludeAWSRoute(
 	ctx context.Context,
 	routes []*svcapitypes.CreateRouteInput,
@@ -318,6 +940,262 @@ func (rm *resourceManager) excludeAWSRoute(
 	return ret, nil
 }
 
+// getMainAssociationID returns the ID of the main route table association
+// among the supplied associations, or nil if the route table is not the main
+// route table of its VPC.
+func getMainAssociationID(
+	associations []*svcapitypes.RouteTableAssociation,
+) *string {
+	for _, assoc := range associations {
+		if aws.ToBool(assoc.Main) {
+			return assoc.RouteTableAssociationID
+		}
+	}
+	return nil
+}
+
+// syncMainRouteTable makes the route table the main route table of its VPC
+// when Spec.Main is true, and records the route table that was main before in
+// Status.OriginalMainRouteTableID. Otherwise the original main route table
+// is restored.
+func (rm *resourceManager) syncMainRouteTable(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncMainRouteTable")
+	defer func() { exit(err) }()
+
+	if !aws.ToBool(desired.ko.Spec.Main) {
+		if err = rm.restoreMainRouteTable(ctx, latest); err != nil {
+			return err
+		}
+		desired.ko.Status.OriginalMainRouteTableID = nil
+		return nil
+	}
+	if getMainAssociationID(latest.ko.Status.Associations) != nil {
+		return nil
+	}
+
+	input := &svcsdk.DescribeRouteTablesInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{*latest.ko.Spec.VPCID},
+			},
+			{
+				Name:   aws.String("association.main"),
+				Values: []string{"true"},
+			},
+		},
+	}
+	resp, err := rm.sdkapi.DescribeRouteTables(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeRouteTables", err)
+	if err != nil {
+		return err
+	}
+	var mainAssociationID, mainRouteTableID *string
+	for _, rt := range resp.RouteTables {
+		for _, assoc := range rt.Associations {
+			if aws.ToBool(assoc.Main) {
+				mainAssociationID = assoc.RouteTableAssociationId
+				mainRouteTableID = rt.RouteTableId
+			}
+		}
+	}
+	if mainAssociationID == nil {
+		return fmt.Errorf("main route table association of VPC %s not found", *latest.ko.Spec.VPCID)
+	}
+
+	// The first main route table is kept when the main route table was
+	// replaced again outside of the controller.
+	if desired.ko.Status.OriginalMainRouteTableID == nil {
+		desired.ko.Status.OriginalMainRouteTableID = mainRouteTableID
+	}
+	return rm.replaceMainRouteTableAssociation(ctx, mainAssociationID, latest.ko.Status.RouteTableID)
+}
+
+// restoreMainRouteTable makes the route table recorded in
+// Status.OriginalMainRouteTableID the main route table of the VPC again, if
+// the supplied route table is main. If the original main route table no
+// longer exists, a terminal error asks for another route table to be made
+// main, as the main route table can neither be unset nor deleted.
+func (rm *resourceManager) restoreMainRouteTable(
+	ctx context.Context,
+	r *resource,
+) error {
+	associationID := getMainAssociationID(r.ko.Status.Associations)
+	if associationID == nil || r.ko.Status.OriginalMainRouteTableID == nil {
+		return nil
+	}
+	err := rm.replaceMainRouteTableAssociation(ctx, associationID, r.ko.Status.OriginalMainRouteTableID)
+	if isRouteTableNotFound(err) {
+		return newOriginalMainRouteTableNotFoundError(r)
+	}
+	return err
+}
+
+// newOriginalMainRouteTableNotFoundError returns the terminal error reported
+// when the original main route table of the VPC no longer exists.
+func newOriginalMainRouteTableNotFoundError(r *resource) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"original main route table %s of VPC %s no longer exists; make another "+
+			"route table the main route table of the VPC before unsetting main "+
+			"or deleting route table %s",
+		aws.ToString(r.ko.Status.OriginalMainRouteTableID),
+		aws.ToString(r.ko.Spec.VPCID),
+		aws.ToString(r.ko.Status.RouteTableID),
+	))
+}
+
+// isRouteTableNotFound returns true if the supplied error is returned by EC2
+// for a route table that does not exist.
+func isRouteTableNotFound(err error) bool {
+	awsErr, ok := ackerr.AWSError(err)
+	return ok && awsErr.ErrorCode() == "InvalidRouteTableID.NotFound"
+}
+
+func (rm *resourceManager) replaceMainRouteTableAssociation(
+	ctx context.Context,
+	associationID *string,
+	routeTableID *string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.replaceMainRouteTableAssociation")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.ReplaceRouteTableAssociationInput{
+		AssociationId: associationID,
+		RouteTableId:  routeTableID,
+	}
+	_, err = rm.sdkapi.ReplaceRouteTableAssociation(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ReplaceRouteTableAssociation", err)
+	return err
+}
+
+// getEdgeGatewayID returns the ID of the gateway the route table should be
+// associated with, set either in Spec.GatewayID or Spec.VPNGatewayID.
+func getEdgeGatewayID(ko *svcapitypes.RouteTable) *string {
+	if ko.Spec.GatewayID != nil {
+		return ko.Spec.GatewayID
+	}
+	return ko.Spec.VPNGatewayID
+}
+
+// managesGatewayAssociation returns true if the edge association of the route
+// table is set through Spec.GatewayID, Spec.VPNGatewayID or their references.
+// Otherwise the association is left alone, as the InternetGateway resource
+// associates the route tables listed in its Spec.RouteTables.
+func managesGatewayAssociation(ko *svcapitypes.RouteTable) bool {
+	return ko.Spec.GatewayID != nil || ko.Spec.GatewayRef != nil ||
+		ko.Spec.VPNGatewayID != nil || ko.Spec.VPNGatewayRef != nil
+}
+
+// getGatewayAssociation returns the association between the route table and
+// a gateway among the supplied associations, or nil if there is none. An
+// association that is being removed is ignored.
+func getGatewayAssociation(
+	associations []*svcapitypes.RouteTableAssociation,
+) *svcapitypes.RouteTableAssociation {
+	for _, assoc := range associations {
+		if assoc.GatewayID == nil || assoc.AssociationState == nil {
+			continue
+		}
+		switch aws.ToString(assoc.AssociationState.State) {
+		case string(svcsdktypes.RouteTableAssociationStateCodeAssociated),
+			string(svcsdktypes.RouteTableAssociationStateCodeAssociating):
+			return assoc
+		}
+	}
+	return nil
+}
+
+// setGatewayIDs sets Spec.GatewayID or Spec.VPNGatewayID from the gateway
+// association of the route table, depending on the type of the gateway.
+func setGatewayIDs(ko *svcapitypes.RouteTable) {
+	ko.Spec.GatewayID = nil
+	ko.Spec.VPNGatewayID = nil
+	assoc := getGatewayAssociation(ko.Status.Associations)
+	if assoc == nil {
+		return
+	}
+	if strings.HasPrefix(*assoc.GatewayID, "vgw-") {
+		ko.Spec.VPNGatewayID = assoc.GatewayID
+	} else {
+		ko.Spec.GatewayID = assoc.GatewayID
+	}
+}
+
+// syncGatewayAssociation associates the route table with the gateway set in
+// Spec.GatewayID or Spec.VPNGatewayID, replacing the association with any
+// other gateway. A route table is associated with at most one gateway.
+func (rm *resourceManager) syncGatewayAssociation(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncGatewayAssociation")
+	defer func() { exit(err) }()
+
+	if desired.ko.Spec.GatewayID != nil && desired.ko.Spec.VPNGatewayID != nil {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"only one of GatewayID and VPNGatewayID can be set",
+		))
+	}
+	gatewayID := getEdgeGatewayID(desired.ko)
+	assoc := getGatewayAssociation(latest.ko.Status.Associations)
+	if assoc != nil && aws.ToString(assoc.GatewayID) == aws.ToString(gatewayID) {
+		return nil
+	}
+	if assoc != nil {
+		if err = rm.disassociateRouteTable(ctx, assoc.RouteTableAssociationID); err != nil {
+			return err
+		}
+	}
+	if gatewayID == nil {
+		return nil
+	}
+
+	input := &svcsdk.AssociateRouteTableInput{
+		GatewayId:    gatewayID,
+		RouteTableId: latest.ko.Status.RouteTableID,
+	}
+	_, err = rm.sdkapi.AssociateRouteTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AssociateRouteTable", err)
+	return err
+}
+
+// disassociateGateway removes the association between the route table and a
+// gateway, which prevents the route table from being deleted.
+func (rm *resourceManager) disassociateGateway(
+	ctx context.Context,
+	r *resource,
+) error {
+	assoc := getGatewayAssociation(r.ko.Status.Associations)
+	if assoc == nil {
+		return nil
+	}
+	return rm.disassociateRouteTable(ctx, assoc.RouteTableAssociationID)
+}
+
+func (rm *resourceManager) disassociateRouteTable(
+	ctx context.Context,
+	associationID *string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disassociateRouteTable")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.DisassociateRouteTableInput{
+		AssociationId: associationID,
+	}
+	_, err = rm.sdkapi.DisassociateRouteTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DisassociateRouteTable", err)
+	return err
+}
+
 // updateTagSpecificationsInCreateRequest adds
 // Tags defined in the Spec to CreateRouteTableInput.TagSpecification
 // and ensures the ResourceType is always set to 'route-table'
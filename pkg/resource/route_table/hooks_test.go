@@ -1,12 +1,22 @@
 package route_table
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestCustomerPreCompare(t *testing.T) {
@@ -104,3 +114,479 @@ func TestCustomerPreCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomPreCompareVGWRoutePropagation(t *testing.T) {
+	vgwRoute := func(vgwID string, cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			GatewayID:            aws.String(vgwID),
+		}
+	}
+	routeStatus := func(vgwID string, cidr string, origin string) *svcapitypes.Route_SDK {
+		return &svcapitypes.Route_SDK{
+			DestinationCIDRBlock: aws.String(cidr),
+			GatewayID:            aws.String(vgwID),
+			Origin:               aws.String(origin),
+		}
+	}
+
+	t.Run("propagated routes are not deleted", func(t *testing.T) {
+		a := &resource{ko: &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{
+				PropagatingVPNGateways: aws.StringSlice([]string{"vgw-1"}),
+			},
+		}}
+		b := &resource{ko: &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{
+				PropagatingVPNGateways: aws.StringSlice([]string{"vgw-1"}),
+				Routes:                 []*svcapitypes.CreateRouteInput{vgwRoute("vgw-1", "192.168.0.0/24")},
+			},
+			Status: svcapitypes.RouteTableStatus{
+				RouteStatuses: []*svcapitypes.Route_SDK{routeStatus("vgw-1", "192.168.0.0/24", "EnableVgwRoutePropagation")},
+			},
+		}}
+		delta := ackcompare.NewDelta()
+		customPreCompare(delta, a, b)
+		assert.Empty(t, delta.Differences)
+	})
+
+	t.Run("static routes to a VPN gateway are deleted", func(t *testing.T) {
+		a := &resource{ko: &svcapitypes.RouteTable{}}
+		b := &resource{ko: &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{
+				Routes: []*svcapitypes.CreateRouteInput{vgwRoute("vgw-1", "192.168.0.0/24")},
+			},
+			Status: svcapitypes.RouteTableStatus{
+				RouteStatuses: []*svcapitypes.Route_SDK{routeStatus("vgw-1", "192.168.0.0/24", "CreateRoute")},
+			},
+		}}
+		delta := ackcompare.NewDelta()
+		customPreCompare(delta, a, b)
+		assert.True(t, delta.DifferentAt("Spec.Routes"))
+	})
+
+	tt := []struct {
+		id        string
+		desired   []string
+		latest    []string
+		toEnable  []string
+		toDisable []string
+	}{
+		{"identical", []string{"vgw-1", "vgw-2"}, []string{"vgw-2", "vgw-1"}, nil, nil},
+		{"enable", []string{"vgw-1"}, nil, []string{"vgw-1"}, nil},
+		{"disable", nil, []string{"vgw-1"}, nil, []string{"vgw-1"}},
+		{"replace", []string{"vgw-2"}, []string{"vgw-1"}, []string{"vgw-2"}, []string{"vgw-1"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.RouteTable{
+				Spec: svcapitypes.RouteTableSpec{PropagatingVPNGateways: aws.StringSlice(tc.desired)},
+			}}
+			b := &resource{ko: &svcapitypes.RouteTable{
+				Spec: svcapitypes.RouteTableSpec{PropagatingVPNGateways: aws.StringSlice(tc.latest)},
+			}}
+			toEnable, toDisable := getVPNGatewayIDsDifference(a.ko.Spec.PropagatingVPNGateways, b.ko.Spec.PropagatingVPNGateways)
+			assert.Equal(t, tc.toEnable, toEnable)
+			assert.Equal(t, tc.toDisable, toDisable)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, len(toEnable) > 0 || len(toDisable) > 0, delta.DifferentAt("Spec.PropagatingVPNGateways"))
+		})
+	}
+}
+
+func TestCustomPreCompareMain(t *testing.T) {
+	mainAssociation := &svcapitypes.RouteTableAssociation{
+		Main:                    aws.Bool(true),
+		RouteTableAssociationID: aws.String("rtbassoc-1"),
+	}
+	subnetAssociation := &svcapitypes.RouteTableAssociation{
+		Main:                    aws.Bool(false),
+		RouteTableAssociationID: aws.String("rtbassoc-2"),
+		SubnetID:                aws.String("subnet-1"),
+	}
+	assert.Equal(t, "rtbassoc-1", *getMainAssociationID([]*svcapitypes.RouteTableAssociation{subnetAssociation, mainAssociation}))
+	assert.Nil(t, getMainAssociationID([]*svcapitypes.RouteTableAssociation{subnetAssociation}))
+
+	tt := []struct {
+		id               string
+		desired          *bool
+		latest           *bool
+		originalMainRTID *string
+		different        bool
+	}{
+		{"unset", nil, nil, nil, false},
+		{"make main", aws.Bool(true), aws.Bool(false), nil, true},
+		{"already main", aws.Bool(true), aws.Bool(true), nil, false},
+		{"main set outside the controller", nil, aws.Bool(true), nil, false},
+		{"restore original main", aws.Bool(false), aws.Bool(true), aws.String("rtb-1"), true},
+		{"unset restores original main", nil, aws.Bool(true), aws.String("rtb-1"), true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.RouteTable{
+				Spec: svcapitypes.RouteTableSpec{Main: tc.desired},
+			}}
+			b := &resource{ko: &svcapitypes.RouteTable{
+				Spec:   svcapitypes.RouteTableSpec{Main: tc.latest},
+				Status: svcapitypes.RouteTableStatus{OriginalMainRouteTableID: tc.originalMainRTID},
+			}}
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, tc.different, delta.DifferentAt("Spec.Main"))
+		})
+	}
+}
+
+func TestCustomPreCompareGatewayAssociation(t *testing.T) {
+	gatewayAssociation := func(gatewayID string, state string) *svcapitypes.RouteTableAssociation {
+		return &svcapitypes.RouteTableAssociation{
+			AssociationState:        &svcapitypes.RouteTableAssociationState{State: aws.String(state)},
+			GatewayID:               aws.String(gatewayID),
+			RouteTableAssociationID: aws.String("rtbassoc-1"),
+		}
+	}
+
+	t.Run("gateway IDs are set from the association", func(t *testing.T) {
+		ko := &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{GatewayID: aws.String("igw-1")},
+			Status: svcapitypes.RouteTableStatus{
+				Associations: []*svcapitypes.RouteTableAssociation{gatewayAssociation("vgw-1", "associated")},
+			},
+		}
+		setGatewayIDs(ko)
+		assert.Nil(t, ko.Spec.GatewayID)
+		assert.Equal(t, "vgw-1", *ko.Spec.VPNGatewayID)
+
+		ko.Status.Associations = []*svcapitypes.RouteTableAssociation{gatewayAssociation("igw-1", "associating")}
+		setGatewayIDs(ko)
+		assert.Equal(t, "igw-1", *ko.Spec.GatewayID)
+		assert.Nil(t, ko.Spec.VPNGatewayID)
+
+		ko.Status.Associations = []*svcapitypes.RouteTableAssociation{gatewayAssociation("igw-1", "disassociating")}
+		setGatewayIDs(ko)
+		assert.Nil(t, ko.Spec.GatewayID)
+		assert.Nil(t, ko.Spec.VPNGatewayID)
+	})
+
+	tt := []struct {
+		id           string
+		desiredIGW   *string
+		desiredVGW   *string
+		latestIGW    *string
+		latestVGW    *string
+		igwDifferent bool
+		vgwDifferent bool
+	}{
+		{"unset", nil, nil, nil, nil, false, false},
+		{"associate internet gateway", aws.String("igw-1"), nil, nil, nil, true, false},
+		{"associate virtual private gateway", nil, aws.String("vgw-1"), nil, nil, false, true},
+		{"identical", aws.String("igw-1"), nil, aws.String("igw-1"), nil, false, false},
+		{"virtual private gateway set as gateway", aws.String("vgw-1"), nil, nil, aws.String("vgw-1"), false, false},
+		{"replace", nil, aws.String("vgw-1"), aws.String("igw-1"), nil, true, true},
+		{"association left to the internet gateway", nil, nil, aws.String("igw-1"), nil, false, false},
+		{"association left unmanaged", nil, nil, nil, aws.String("vgw-1"), false, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.RouteTable{
+				Spec: svcapitypes.RouteTableSpec{GatewayID: tc.desiredIGW, VPNGatewayID: tc.desiredVGW},
+			}}
+			b := &resource{ko: &svcapitypes.RouteTable{
+				Spec: svcapitypes.RouteTableSpec{GatewayID: tc.latestIGW, VPNGatewayID: tc.latestVGW},
+			}}
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, tc.igwDifferent, delta.DifferentAt("Spec.GatewayID"))
+			assert.Equal(t, tc.vgwDifferent, delta.DifferentAt("Spec.VPNGatewayID"))
+		})
+	}
+}
+
+func TestGetRoutesToReplace(t *testing.T) {
+	natRoute := func(natID string, cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			NATGatewayID:         aws.String(natID),
+		}
+	}
+	prefixListRoute := &svcapitypes.CreateRouteInput{
+		DestinationPrefixListID: aws.String("pl-1"),
+		GatewayID:               aws.String("igw-1"),
+	}
+
+	toAdd, toDelete := getRoutesDifference(
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-2", "0.0.0.0/0"), natRoute("nat-1", "10.1.0.0/16")},
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-1", "0.0.0.0/0"), prefixListRoute},
+	)
+	toReplace, toAdd, toDelete := getRoutesToReplace(toAdd, toDelete)
+	assert.Equal(t, []*svcapitypes.CreateRouteInput{natRoute("nat-2", "0.0.0.0/0")}, toReplace)
+	assert.Equal(t, []*svcapitypes.CreateRouteInput{natRoute("nat-1", "10.1.0.0/16")}, toAdd)
+	assert.Equal(t, []*svcapitypes.CreateRouteInput{prefixListRoute}, toDelete)
+	assert.Equal(t, "pl-1", getRouteDestination(prefixListRoute))
+}
+
+func TestGetRemainingRouteFailures(t *testing.T) {
+	natRoute := func(natID string, cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			NATGatewayID:         aws.String(natID),
+		}
+	}
+	failures := map[string]*string{
+		"0.0.0.0/0":   aws.String("InvalidNatGatewayID.NotFound"),
+		"10.1.0.0/16": aws.String("RouteAlreadyExists"),
+	}
+
+	remaining := getRemainingRouteFailures(
+		failures,
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-2", "0.0.0.0/0"), natRoute("nat-1", "10.1.0.0/16")},
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-1", "0.0.0.0/0"), natRoute("nat-1", "10.1.0.0/16")},
+	)
+	assert.Equal(t, map[string]*string{"0.0.0.0/0": failures["0.0.0.0/0"]}, remaining)
+
+	remaining = getRemainingRouteFailures(
+		failures,
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-2", "0.0.0.0/0")},
+		[]*svcapitypes.CreateRouteInput{natRoute("nat-2", "0.0.0.0/0")},
+	)
+	assert.Nil(t, remaining)
+}
+
+func TestNormalizeInstanceRoutes(t *testing.T) {
+	instanceRoute := func(cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			InstanceID:           aws.String("i-1"),
+			NetworkInterfaceID:   aws.String("eni-1"),
+		}
+	}
+	desired := []*svcapitypes.CreateRouteInput{
+		{DestinationCIDRBlock: aws.String("10.1.0.0/16"), InstanceID: aws.String("i-1")},
+		{DestinationCIDRBlock: aws.String("10.2.0.0/16"), NetworkInterfaceID: aws.String("eni-1")},
+	}
+	latest := []*svcapitypes.CreateRouteInput{
+		instanceRoute("10.1.0.0/16"),
+		instanceRoute("10.2.0.0/16"),
+		instanceRoute("10.3.0.0/16"),
+	}
+
+	normalizeInstanceRoutes(desired, latest)
+	toAdd, toDelete := getRoutesDifference(desired, latest)
+	assert.Empty(t, toAdd)
+	assert.Len(t, toDelete, 1)
+	assert.Equal(t, "i-1", *toDelete[0].InstanceID)
+	assert.Nil(t, toDelete[0].NetworkInterfaceID)
+}
+
+func TestSetBlackholeRoutesCondition(t *testing.T) {
+	routeStatus := func(cidr string, state string) *svcapitypes.Route_SDK {
+		return &svcapitypes.Route_SDK{
+			DestinationCIDRBlock: aws.String(cidr),
+			NATGatewayID:         aws.String("nat-1"),
+			State:                aws.String(state),
+		}
+	}
+	newResource := func(policy *string, statuses ...*svcapitypes.Route_SDK) *resource {
+		return &resource{ko: &svcapitypes.RouteTable{
+			Spec:   svcapitypes.RouteTableSpec{BlackholeRoutePolicy: policy},
+			Status: svcapitypes.RouteTableStatus{RouteStatuses: statuses},
+		}}
+	}
+	refRoute := &svcapitypes.CreateRouteInput{
+		DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+		NATGatewayID:         aws.String("nat-1"),
+		NATGatewayRef:        &ackv1alpha1.AWSResourceReferenceWrapper{},
+	}
+	idRoute := &svcapitypes.CreateRouteInput{
+		DestinationCIDRBlock: aws.String("10.1.0.0/16"),
+		NATGatewayID:         aws.String("nat-1"),
+	}
+
+	t.Run("no blackhole routes", func(t *testing.T) {
+		r := newResource(nil, routeStatus("0.0.0.0/0", "active"))
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute})
+		assert.Empty(t, r.ko.Status.Conditions)
+	})
+
+	t.Run("blackhole routes are reported", func(t *testing.T) {
+		r := newResource(nil, routeStatus("0.0.0.0/0", "blackhole"), routeStatus("10.1.0.0/16", "blackhole"))
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute, idRoute})
+		assert.Len(t, r.ko.Status.Conditions, 1)
+		cond := r.ko.Status.Conditions[0]
+		assert.Equal(t, ConditionTypeBlackholeRoutes, cond.Type)
+		assert.Equal(t, corev1.ConditionTrue, cond.Status)
+		assert.Contains(t, *cond.Message, "0.0.0.0/0, 10.1.0.0/16")
+
+		// The condition is kept as is while the blackhole routes are the same
+		transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		cond.LastTransitionTime = &transitionTime
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute, idRoute})
+		assert.Len(t, r.ko.Status.Conditions, 1)
+		assert.Equal(t, transitionTime, *r.ko.Status.Conditions[0].LastTransitionTime)
+
+		// and updated in place when they change
+		r.ko.Status.RouteStatuses = r.ko.Status.RouteStatuses[:1]
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute, idRoute})
+		assert.Len(t, r.ko.Status.Conditions, 1)
+		assert.NotContains(t, *r.ko.Status.Conditions[0].Message, "10.1.0.0/16")
+		assert.True(t, transitionTime.Before(r.ko.Status.Conditions[0].LastTransitionTime))
+	})
+
+	t.Run("condition is cleared once blackhole routes are gone", func(t *testing.T) {
+		r := newResource(nil, routeStatus("0.0.0.0/0", "blackhole"))
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute})
+		ackcondition.SetSynced(r, corev1.ConditionTrue, nil, nil)
+		assert.Len(t, r.ko.Status.Conditions, 2)
+
+		r.ko.Status.RouteStatuses = []*svcapitypes.Route_SDK{routeStatus("0.0.0.0/0", "active")}
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute})
+		assert.Len(t, r.ko.Status.Conditions, 1)
+		assert.NotNil(t, ackcondition.Synced(r))
+	})
+
+	t.Run("recreate policy waits for referenced targets", func(t *testing.T) {
+		r := newResource(aws.String(BlackholeRoutePolicyRecreate), routeStatus("0.0.0.0/0", "blackhole"), routeStatus("10.1.0.0/16", "blackhole"))
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{refRoute, idRoute})
+		synced := ackcondition.Synced(r)
+		assert.NotNil(t, synced)
+		assert.Equal(t, corev1.ConditionFalse, synced.Status)
+		assert.Contains(t, *synced.Message, "0.0.0.0/0")
+		assert.NotContains(t, *synced.Message, "10.1.0.0/16")
+	})
+
+	t.Run("recreate policy without referenced targets", func(t *testing.T) {
+		r := newResource(aws.String(BlackholeRoutePolicyRecreate), routeStatus("10.1.0.0/16", "blackhole"))
+		setBlackholeRoutesCondition(r, []*svcapitypes.CreateRouteInput{idRoute})
+		assert.Nil(t, ackcondition.Synced(r))
+	})
+}
+
+func TestUnmanagedRoutePolicy(t *testing.T) {
+	natRoute := func(natID string, cidr string) *svcapitypes.CreateRouteInput {
+		return &svcapitypes.CreateRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			NATGatewayID:         aws.String(natID),
+		}
+	}
+	routeStatus := func(cidr string, origin string) *svcapitypes.Route_SDK {
+		return &svcapitypes.Route_SDK{
+			DestinationCIDRBlock: aws.String(cidr),
+			NATGatewayID:         aws.String("nat-9"),
+			Origin:               aws.String(origin),
+		}
+	}
+	newDesired := func(policy *string, ignored ...string) *resource {
+		return &resource{ko: &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{
+				IgnoredRouteDestinations: aws.StringSlice(ignored),
+				Routes:                   []*svcapitypes.CreateRouteInput{natRoute("nat-1", "0.0.0.0/0")},
+				UnmanagedRoutePolicy:     policy,
+			},
+		}}
+	}
+	newLatest := func(routeOwners map[string]*string) *resource {
+		return &resource{ko: &svcapitypes.RouteTable{
+			Spec: svcapitypes.RouteTableSpec{
+				Routes: []*svcapitypes.CreateRouteInput{
+					natRoute("nat-2", "0.0.0.0/0"),
+					natRoute("nat-9", "10.1.0.0/16"),
+					natRoute("nat-9", "10.2.0.0/16"),
+				},
+			},
+			Status: svcapitypes.RouteTableStatus{
+				RouteStatuses: []*svcapitypes.Route_SDK{
+					routeStatus("10.0.0.0/16", "CreateRouteTable"),
+					routeStatus("10.1.0.0/16", "CreateRoute"),
+					routeStatus("10.2.0.0/16", "CreateRoute"),
+				},
+				RouteOwners: routeOwners,
+			},
+		}}
+	}
+	owned := map[string]*string{"10.1.0.0/16": aws.String("team-a/peering")}
+
+	tt := []struct {
+		id          string
+		desired     *resource
+		routeOwners map[string]*string
+		toDelete    []string
+		unmanaged   []string
+	}{
+		{"authoritative by default", newDesired(nil), nil, []string{"10.1.0.0/16", "10.2.0.0/16"}, nil},
+		{"authoritative with ignored destinations", newDesired(aws.String(UnmanagedRoutePolicyAuthoritative), "10.2.0.0/16"), nil, []string{"10.1.0.0/16"}, []string{"10.2.0.0/16"}},
+		{"authoritative with owned routes", newDesired(nil), owned, []string{"10.2.0.0/16"}, nil},
+		{"additive", newDesired(aws.String(UnmanagedRoutePolicyAdditive)), nil, nil, []string{"10.1.0.0/16", "10.2.0.0/16"}},
+		{"additive with owned routes", newDesired(aws.String(UnmanagedRoutePolicyAdditive)), owned, nil, []string{"10.2.0.0/16"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			latest := newLatest(tc.routeOwners)
+			unmanaged := lo.Map(getUnmanagedRoutes(tc.desired.ko, latest.ko.Status.RouteStatuses, latest.ko.Status.RouteOwners), func(r *svcapitypes.Route_SDK, _ int) string {
+				return getRouteStatusDestination(r)
+			})
+			assert.ElementsMatch(t, tc.unmanaged, unmanaged)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, tc.desired, latest)
+			assert.True(t, delta.DifferentAt("Spec.Routes"))
+
+			// The declared route to 0.0.0.0/0 is always replaced
+			toAdd, toDelete := getRoutesDifference(tc.desired.ko.Spec.Routes, latest.ko.Spec.Routes)
+			toReplace, toAdd, toDelete := getRoutesToReplace(toAdd, toDelete)
+			assert.Len(t, toReplace, 1)
+			assert.Empty(t, toAdd)
+			assert.ElementsMatch(t, tc.toDelete, lo.Map(toDelete, func(r *svcapitypes.CreateRouteInput, _ int) string {
+				return getRouteDestination(r)
+			}))
+		})
+	}
+}
+
+func TestGetRouteOwners(t *testing.T) {
+	tags := []*svcapitypes.Tag{
+		{Key: aws.String("Name"), Value: aws.String("shared")},
+		{Key: aws.String(GetRouteOwnerTagKey("10.1.0.0/16")), Value: aws.String("team-a/peering")},
+		{Key: aws.String(GetRouteOwnerTagKey("pl-1")), Value: aws.String("team-b/endpoint")},
+	}
+
+	remaining, routeOwners := getRouteOwners(tags)
+	assert.Equal(t, tags[:1], remaining)
+	assert.Equal(t, map[string]*string{
+		"10.1.0.0/16": aws.String("team-a/peering"),
+		"pl-1":        aws.String("team-b/endpoint"),
+	}, routeOwners)
+
+	remaining, routeOwners = getRouteOwners(tags[1:])
+	assert.Nil(t, remaining)
+	assert.Equal(t, 2, len(routeOwners))
+
+	remaining, routeOwners = getRouteOwners(tags[:1])
+	assert.Equal(t, tags[:1], remaining)
+	assert.Nil(t, routeOwners)
+}
+
+func TestIsRouteTableNotFound(t *testing.T) {
+	assert.False(t, isRouteTableNotFound(nil))
+	assert.False(t, isRouteTableNotFound(errors.New("boom")))
+	assert.False(t, isRouteTableNotFound(&smithy.GenericAPIError{Code: "InvalidAssociationID.NotFound"}))
+	assert.True(t, isRouteTableNotFound(&smithy.GenericAPIError{Code: "InvalidRouteTableID.NotFound"}))
+	assert.True(t, isRouteTableNotFound(fmt.Errorf("replacing association: %w",
+		&smithy.GenericAPIError{Code: "InvalidRouteTableID.NotFound"})))
+}
+
+func TestNewOriginalMainRouteTableNotFoundError(t *testing.T) {
+	r := &resource{ko: &svcapitypes.RouteTable{
+		Spec: svcapitypes.RouteTableSpec{VPCID: aws.String("vpc-1")},
+		Status: svcapitypes.RouteTableStatus{
+			OriginalMainRouteTableID: aws.String("rtb-original"),
+			RouteTableID:             aws.String("rtb-main"),
+		},
+	}}
+	err := newOriginalMainRouteTableNotFoundError(r)
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+	assert.Contains(t, err.Error(), "rtb-original")
+	assert.Contains(t, err.Error(), "vpc-1")
+}
@@ -38,18 +38,48 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.GatewayRef != nil {
+		ko.Spec.GatewayID = nil
+	}
+
+	if len(ko.Spec.PropagatingVPNGatewayRefs) > 0 {
+		ko.Spec.PropagatingVPNGateways = nil
+	}
+
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.CarrierGatewayRef != nil {
+			ko.Spec.Routes[f0idx].CarrierGatewayID = nil
+		}
+
+		if f0iter.EgressOnlyInternetGatewayRef != nil {
+			ko.Spec.Routes[f0idx].EgressOnlyInternetGatewayID = nil
+		}
+	}
+
 	for f0idx, f0iter := range ko.Spec.Routes {
 		if f0iter.GatewayRef != nil {
 			ko.Spec.Routes[f0idx].GatewayID = nil
 		}
 	}
 
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.InstanceRef != nil {
+			ko.Spec.Routes[f0idx].InstanceID = nil
+		}
+	}
+
 	for f0idx, f0iter := range ko.Spec.Routes {
 		if f0iter.NATGatewayRef != nil {
 			ko.Spec.Routes[f0idx].NATGatewayID = nil
 		}
 	}
 
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.NetworkInterfaceRef != nil {
+			ko.Spec.Routes[f0idx].NetworkInterfaceID = nil
+		}
+	}
+
 	for f0idx, f0iter := range ko.Spec.Routes {
 		if f0iter.TransitGatewayRef != nil {
 			ko.Spec.Routes[f0idx].TransitGatewayID = nil
@@ -72,6 +102,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.VPCID = nil
 	}
 
+	if ko.Spec.VPNGatewayRef != nil {
+		ko.Spec.VPNGatewayID = nil
+	}
+
 	return &resource{ko}
 }
 
@@ -91,84 +125,533 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForGatewayID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForPropagatingVPNGateways(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_CarrierGatewayID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_EgressOnlyInternetGatewayID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForRoutes_GatewayID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_InstanceID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForRoutes_NATGatewayID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_NetworkInterfaceID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForRoutes_TransitGatewayID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
-
-	if fieldHasReferences, err := rm.resolveReferenceForRoutes_VPCEndpointID(ctx, apiReader, ko); err != nil {
-		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
-	} else {
-		resourceHasReferences = resourceHasReferences || fieldHasReferences
+
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_VPCEndpointID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForRoutes_VPCPeeringConnectionID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForVPCID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForVPNGatewayID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	return &resource{ko}, resourceHasReferences, err
+}
+
+// validateReferenceFields validates the reference field and corresponding
+// identifier field.
+func validateReferenceFields(ko *svcapitypes.RouteTable) error {
+
+	if ko.Spec.GatewayRef != nil && ko.Spec.GatewayID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("GatewayID", "GatewayRef")
+	}
+
+	if len(ko.Spec.PropagatingVPNGatewayRefs) > 0 && len(ko.Spec.PropagatingVPNGateways) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("PropagatingVPNGateways", "PropagatingVPNGatewayRefs")
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.CarrierGatewayRef != nil && f0iter.CarrierGatewayID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.CarrierGatewayID", "Routes.CarrierGatewayRef")
+		}
+
+		if f0iter.EgressOnlyInternetGatewayRef != nil && f0iter.EgressOnlyInternetGatewayID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.EgressOnlyInternetGatewayID", "Routes.EgressOnlyInternetGatewayRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.GatewayRef != nil && f0iter.GatewayID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.GatewayID", "Routes.GatewayRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.InstanceRef != nil && f0iter.InstanceID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.InstanceID", "Routes.InstanceRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.NATGatewayRef != nil && f0iter.NATGatewayID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.NATGatewayID", "Routes.NATGatewayRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.NetworkInterfaceRef != nil && f0iter.NetworkInterfaceID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.NetworkInterfaceID", "Routes.NetworkInterfaceRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.TransitGatewayRef != nil && f0iter.TransitGatewayID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.TransitGatewayID", "Routes.TransitGatewayRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.VPCEndpointRef != nil && f0iter.VPCEndpointID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.VPCEndpointID", "Routes.VPCEndpointRef")
+		}
+	}
+
+	for _, f0iter := range ko.Spec.Routes {
+		if f0iter.VPCPeeringConnectionRef != nil && f0iter.VPCPeeringConnectionID != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.VPCPeeringConnectionID", "Routes.VPCPeeringConnectionRef")
+		}
+	}
+
+	if ko.Spec.VPCRef != nil && ko.Spec.VPCID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("VPCID", "VPCRef")
+	}
+	if ko.Spec.VPCRef == nil && ko.Spec.VPCID == nil {
+		return ackerr.ResourceReferenceOrIDRequiredFor("VPCID", "VPCRef")
+	}
+
+	if ko.Spec.VPNGatewayRef != nil && ko.Spec.VPNGatewayID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("VPNGatewayID", "VPNGatewayRef")
+	}
+	return nil
+}
+
+// resolveReferenceForGatewayID reads the resource referenced
+// from GatewayRef field and sets the GatewayID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForGatewayID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	if ko.Spec.GatewayRef != nil && ko.Spec.GatewayRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.GatewayRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: GatewayRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.InternetGateway{}
+		if err := getReferencedResourceState_InternetGateway(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.GatewayID = (*string)(obj.Status.InternetGatewayID)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_InternetGateway looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_InternetGateway(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.InternetGateway,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"InternetGateway",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"InternetGateway",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"InternetGateway",
+			namespace, name)
+	}
+	if obj.Status.InternetGatewayID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"InternetGateway",
+			namespace, name,
+			"Status.InternetGatewayID")
+	}
+	return nil
+}
+
+// resolveReferenceForPropagatingVPNGateways reads the resource referenced
+// from PropagatingVPNGatewayRefs field and sets the PropagatingVPNGateways
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForPropagatingVPNGateways(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	for _, f0iter := range ko.Spec.PropagatingVPNGatewayRefs {
+		if f0iter != nil && f0iter.From != nil {
+			hasReferences = true
+			arr := f0iter.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: PropagatingVPNGatewayRefs")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.VPNGateway{}
+			if err := getReferencedResourceState_VPNGateway(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			if ko.Spec.PropagatingVPNGateways == nil {
+				ko.Spec.PropagatingVPNGateways = make([]*string, 0, 1)
+			}
+			ko.Spec.PropagatingVPNGateways = append(ko.Spec.PropagatingVPNGateways, (*string)(obj.Status.VPNGatewayID))
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_VPNGateway looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_VPNGateway(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.VPNGateway,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"VPNGateway",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"VPNGateway",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"VPNGateway",
+			namespace, name)
+	}
+	if obj.Status.VPNGatewayID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"VPNGateway",
+			namespace, name,
+			"Status.VPNGatewayID")
+	}
+	return nil
+}
+
+// resolveReferenceForRoutes_CarrierGatewayID reads the resource referenced
+// from Routes.CarrierGatewayRef field and sets the Routes.CarrierGatewayID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoutes_CarrierGatewayID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.CarrierGatewayRef != nil && f0iter.CarrierGatewayRef.From != nil {
+			hasReferences = true
+			arr := f0iter.CarrierGatewayRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Routes.CarrierGatewayRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.CarrierGateway{}
+			if err := getReferencedResourceState_CarrierGateway(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Routes[f0idx].CarrierGatewayID = (*string)(obj.Status.CarrierGatewayID)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_CarrierGateway looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_CarrierGateway(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.CarrierGateway,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"CarrierGateway",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"CarrierGateway",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"CarrierGateway",
+			namespace, name)
 	}
-
-	if fieldHasReferences, err := rm.resolveReferenceForRoutes_VPCPeeringConnectionID(ctx, apiReader, ko); err != nil {
-		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
-	} else {
-		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	if obj.Status.CarrierGatewayID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"CarrierGateway",
+			namespace, name,
+			"Status.CarrierGatewayID")
 	}
+	return nil
+}
 
-	if fieldHasReferences, err := rm.resolveReferenceForVPCID(ctx, apiReader, ko); err != nil {
-		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
-	} else {
-		resourceHasReferences = resourceHasReferences || fieldHasReferences
+// resolveReferenceForRoutes_EgressOnlyInternetGatewayID reads the resource referenced
+// from Routes.EgressOnlyInternetGatewayRef field and sets the Routes.EgressOnlyInternetGatewayID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoutes_EgressOnlyInternetGatewayID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.EgressOnlyInternetGatewayRef != nil && f0iter.EgressOnlyInternetGatewayRef.From != nil {
+			hasReferences = true
+			arr := f0iter.EgressOnlyInternetGatewayRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Routes.EgressOnlyInternetGatewayRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.EgressOnlyInternetGateway{}
+			if err := getReferencedResourceState_EgressOnlyInternetGateway(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Routes[f0idx].EgressOnlyInternetGatewayID = (*string)(obj.Status.ID)
+		}
 	}
 
-	return &resource{ko}, resourceHasReferences, err
+	return hasReferences, nil
 }
 
-// validateReferenceFields validates the reference field and corresponding
-// identifier field.
-func validateReferenceFields(ko *svcapitypes.RouteTable) error {
-
-	for _, f0iter := range ko.Spec.Routes {
-		if f0iter.GatewayRef != nil && f0iter.GatewayID != nil {
-			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.GatewayID", "Routes.GatewayRef")
-		}
+// getReferencedResourceState_EgressOnlyInternetGateway looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_EgressOnlyInternetGateway(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.EgressOnlyInternetGateway,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
 	}
-
-	for _, f0iter := range ko.Spec.Routes {
-		if f0iter.NATGatewayRef != nil && f0iter.NATGatewayID != nil {
-			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.NATGatewayID", "Routes.NATGatewayRef")
-		}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
 	}
-
-	for _, f0iter := range ko.Spec.Routes {
-		if f0iter.TransitGatewayRef != nil && f0iter.TransitGatewayID != nil {
-			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.TransitGatewayID", "Routes.TransitGatewayRef")
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"EgressOnlyInternetGateway",
+				namespace, name)
 		}
 	}
-
-	for _, f0iter := range ko.Spec.Routes {
-		if f0iter.VPCEndpointRef != nil && f0iter.VPCEndpointID != nil {
-			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.VPCEndpointID", "Routes.VPCEndpointRef")
-		}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"EgressOnlyInternetGateway",
+			namespace, name)
 	}
-
-	for _, f0iter := range ko.Spec.Routes {
-		if f0iter.VPCPeeringConnectionRef != nil && f0iter.VPCPeeringConnectionID != nil {
-			return ackerr.ResourceReferenceAndIDNotSupportedFor("Routes.VPCPeeringConnectionID", "Routes.VPCPeeringConnectionRef")
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
 		}
 	}
-
-	if ko.Spec.VPCRef != nil && ko.Spec.VPCID != nil {
-		return ackerr.ResourceReferenceAndIDNotSupportedFor("VPCID", "VPCRef")
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"EgressOnlyInternetGateway",
+			namespace, name)
 	}
-	if ko.Spec.VPCRef == nil && ko.Spec.VPCID == nil {
-		return ackerr.ResourceReferenceOrIDRequiredFor("VPCID", "VPCRef")
+	if obj.Status.ID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"EgressOnlyInternetGateway",
+			namespace, name,
+			"Status.ID")
 	}
 	return nil
 }
@@ -212,14 +695,53 @@ func (rm *resourceManager) resolveReferenceForRoutes_GatewayID(
 	return hasReferences, nil
 }
 
-// getReferencedResourceState_InternetGateway looks up whether a referenced resource
+// resolveReferenceForRoutes_InstanceID reads the resource referenced
+// from Routes.InstanceRef field and sets the Routes.InstanceID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoutes_InstanceID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.InstanceRef != nil && f0iter.InstanceRef.From != nil {
+			hasReferences = true
+			arr := f0iter.InstanceRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Routes.InstanceRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.Instance{}
+			if err := getReferencedResourceState_Instance(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Routes[f0idx].InstanceID = (*string)(obj.Status.InstanceID)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_Instance looks up whether a referenced resource
 // exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
 // in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
 // `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
-func getReferencedResourceState_InternetGateway(
+func getReferencedResourceState_Instance(
 	ctx context.Context,
 	apiReader client.Reader,
-	obj *svcapitypes.InternetGateway,
+	obj *svcapitypes.Instance,
 	name string, // the Kubernetes name of the referenced resource
 	namespace string, // the Kubernetes namespace of the referenced resource
 ) error {
@@ -236,13 +758,13 @@ func getReferencedResourceState_InternetGateway(
 		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
 			cond.Status == corev1.ConditionTrue {
 			return ackerr.ResourceReferenceTerminalFor(
-				"InternetGateway",
+				"Instance",
 				namespace, name)
 		}
 	}
 	if refResourceTerminal {
 		return ackerr.ResourceReferenceTerminalFor(
-			"InternetGateway",
+			"Instance",
 			namespace, name)
 	}
 	var refResourceSynced bool
@@ -254,14 +776,14 @@ func getReferencedResourceState_InternetGateway(
 	}
 	if !refResourceSynced {
 		return ackerr.ResourceReferenceNotSyncedFor(
-			"InternetGateway",
+			"Instance",
 			namespace, name)
 	}
-	if obj.Status.InternetGatewayID == nil {
+	if obj.Status.InstanceID == nil {
 		return ackerr.ResourceReferenceMissingTargetFieldFor(
-			"InternetGateway",
+			"Instance",
 			namespace, name,
-			"Status.InternetGatewayID")
+			"Status.InstanceID")
 	}
 	return nil
 }
@@ -359,6 +881,99 @@ func getReferencedResourceState_NATGateway(
 	return nil
 }
 
+// resolveReferenceForRoutes_NetworkInterfaceID reads the resource referenced
+// from Routes.NetworkInterfaceRef field and sets the Routes.NetworkInterfaceID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoutes_NetworkInterfaceID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.Routes {
+		if f0iter.NetworkInterfaceRef != nil && f0iter.NetworkInterfaceRef.From != nil {
+			hasReferences = true
+			arr := f0iter.NetworkInterfaceRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: Routes.NetworkInterfaceRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &svcapitypes.NetworkInterface{}
+			if err := getReferencedResourceState_NetworkInterface(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.Routes[f0idx].NetworkInterfaceID = (*string)(obj.Status.NetworkInterfaceID)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_NetworkInterface looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_NetworkInterface(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.NetworkInterface,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"NetworkInterface",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"NetworkInterface",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"NetworkInterface",
+			namespace, name)
+	}
+	if obj.Status.NetworkInterfaceID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"NetworkInterface",
+			namespace, name,
+			"Status.NetworkInterfaceID")
+	}
+	return nil
+}
+
 // resolveReferenceForRoutes_TransitGatewayID reads the resource referenced
 // from Routes.TransitGatewayRef field and sets the Routes.TransitGatewayID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -728,3 +1343,40 @@ func getReferencedResourceState_VPC(
 	}
 	return nil
 }
+
+// resolveReferenceForVPNGatewayID reads the resource referenced
+// from VPNGatewayRef field and sets the VPNGatewayID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForVPNGatewayID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.RouteTable,
+) (hasReferences bool, err error) {
+	if ko.Spec.VPNGatewayRef != nil && ko.Spec.VPNGatewayRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.VPNGatewayRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: VPNGatewayRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.VPNGateway{}
+		if err := getReferencedResourceState_VPNGateway(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.VPNGatewayID = (*string)(obj.Status.VPNGatewayID)
+	}
+
+	return hasReferences, nil
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
@@ -231,6 +232,17 @@ func (rm *resourceManager) sdkFind(
 	if found {
 		rm.addRoutesToStatus(ko, resp.RouteTables[0])
 	}
+	ko.Spec.PropagatingVPNGateways = getPropagatingVPNGatewayIDs(ko.Status.PropagatingVGWs)
+	// Spec.Main is only reported as false when it is set in the resource.
+	if getMainAssociationID(ko.Status.Associations) != nil {
+		ko.Spec.Main = aws.Bool(true)
+	} else if ko.Spec.Main != nil {
+		ko.Spec.Main = aws.Bool(false)
+	}
+	setGatewayIDs(ko)
+	// The tags with which Route resources claim routes of the route table are
+	// not managed through Spec.Tags.
+	ko.Spec.Tags, ko.Status.RouteOwners = getRouteOwners(ko.Spec.Tags)
 	toAdd, toDelete := computeTagsDelta(r.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
 		// if resource's initial tags and response tags are equal,
@@ -251,6 +263,18 @@ func (rm *resourceManager) sdkFind(
 		}
 	}
 
+	// Routes to an instance are described with both the instance and its
+	// network interface as target, only the one set in the resource is kept.
+	normalizeInstanceRoutes(r.ko.Spec.Routes, ko.Spec.Routes)
+
+	// The failures of routes that have been synced since are dropped.
+	ko.Status.RouteFailures = getRemainingRouteFailures(
+		ko.Status.RouteFailures, r.ko.Spec.Routes,
+		removePropagatedRoutes(ko.Spec.Routes, ko.Status.RouteStatuses),
+	)
+	ko.Status.UnmanagedRoutes = getUnmanagedRoutes(r.ko, ko.Status.RouteStatuses, ko.Status.RouteOwners)
+	setBlackholeRoutesCondition(&resource{ko}, r.ko.Spec.Routes)
+
 	return &resource{ko}, nil
 }
 
@@ -260,8 +284,7 @@ func (rm *resourceManager) sdkFind(
 func (rm *resourceManager) requiredFieldsMissingFromReadManyInput(
 	r *resource,
 ) bool {
-	return r.ko.Status.RouteTableID == nil
-
+	return rm.checkForMissingRequiredFields(r)
 }
 
 // newListRequestPayload returns SDK-specific struct for the HTTP request
@@ -454,6 +477,12 @@ func (rm *resourceManager) sdkCreate(
 		}
 	}
 
+	if len(ko.Spec.PropagatingVPNGateways) > 0 {
+		if err := rm.syncVGWRoutePropagations(ctx, &resource{ko}, nil); err != nil {
+			return nil, err
+		}
+	}
+
 	toAdd, toDelete := computeTagsDelta(desired.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
 		// if desired tags and response tags are equal,
@@ -461,6 +490,14 @@ func (rm *resourceManager) sdkCreate(
 		ko.Spec.Tags = desired.ko.Spec.Tags
 	}
 
+	// The main route table and gateway associations are made by the update
+	// path, so that a failure does not fail the creation of the route table.
+	if aws.ToBool(desired.ko.Spec.Main) || getEdgeGatewayID(desired.ko) != nil {
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("RouteTable created, requeue to sync its associations"), nil)
+		err = ackrequeue.NeededAfter(fmt.Errorf("RouteTable created but its associations need to be synced"), time.Second)
+		return &resource{ko}, err
+	}
+
 	return &resource{ko}, nil
 }
 
@@ -500,6 +537,15 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	// A main route table cannot be deleted, the original main route table of
+	// the VPC is restored first.
+	if err = rm.restoreMainRouteTable(ctx, r); err != nil {
+		return nil, err
+	}
+	// Neither can a route table associated with a gateway.
+	if err = rm.disassociateGateway(ctx, r); err != nil {
+		return nil, err
+	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -821,12 +867,63 @@ func (rm *resourceManager) newDeleteRouteInput(
 	return res
 }
 
+func (rm *resourceManager) newReplaceRouteInput(
+	c svcapitypes.CreateRouteInput,
+) *svcsdk.ReplaceRouteInput {
+	res := &svcsdk.ReplaceRouteInput{}
+
+	if c.CarrierGatewayID != nil {
+		res.CarrierGatewayId = c.CarrierGatewayID
+	}
+	if c.CoreNetworkARN != nil {
+		res.CoreNetworkArn = c.CoreNetworkARN
+	}
+	if c.DestinationCIDRBlock != nil {
+		res.DestinationCidrBlock = c.DestinationCIDRBlock
+	}
+	if c.DestinationIPv6CIDRBlock != nil {
+		res.DestinationIpv6CidrBlock = c.DestinationIPv6CIDRBlock
+	}
+	if c.DestinationPrefixListID != nil {
+		res.DestinationPrefixListId = c.DestinationPrefixListID
+	}
+	if c.EgressOnlyInternetGatewayID != nil {
+		res.EgressOnlyInternetGatewayId = c.EgressOnlyInternetGatewayID
+	}
+	if c.GatewayID != nil {
+		res.GatewayId = c.GatewayID
+	}
+	if c.InstanceID != nil {
+		res.InstanceId = c.InstanceID
+	}
+	if c.LocalGatewayID != nil {
+		res.LocalGatewayId = c.LocalGatewayID
+	}
+	if c.NATGatewayID != nil {
+		res.NatGatewayId = c.NATGatewayID
+	}
+	if c.NetworkInterfaceID != nil {
+		res.NetworkInterfaceId = c.NetworkInterfaceID
+	}
+	if c.TransitGatewayID != nil {
+		res.TransitGatewayId = c.TransitGatewayID
+	}
+	if c.VPCEndpointID != nil {
+		res.VpcEndpointId = c.VPCEndpointID
+	}
+	if c.VPCPeeringConnectionID != nil {
+		res.VpcPeeringConnectionId = c.VPCPeeringConnectionID
+	}
+
+	return res
+}
+
 // setRoute sets a resource Route type
 // given the SDK type.
 func (rm *resourceManager) setResourceRoute(
 	resp svcsdktypes.Route,
-) *svcapitypes.Route {
-	res := &svcapitypes.Route{}
+) *svcapitypes.Route_SDK {
+	res := &svcapitypes.Route_SDK{}
 
 	if resp.CarrierGatewayId != nil {
 		res.CarrierGatewayID = resp.CarrierGatewayId
@@ -16,17 +16,24 @@ package security_group
 import (
 	"context"
 	"net"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	awserr "github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 
@@ -34,6 +41,32 @@ import (
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
 )
 
+// NewResourceManager returns a manager for SecurityGroup resources in the given
+// account and region. The DefaultSecurityGroup resource uses it to manage
+// the default security group of a VPC like any other security group.
+func NewResourceManager(
+	cfg ackcfg.Config,
+	clientcfg awsv2.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) (acktypes.AWSResourceManager, error) {
+	return newResourceManager(cfg, clientcfg, log, metrics, rr, id, region)
+}
+
+// NewResourceDescriptor returns the descriptor of SecurityGroup resources
+func NewResourceDescriptor() acktypes.AWSResourceDescriptor {
+	return &resourceDescriptor{}
+}
+
+// RuleOwnerTagKey is the key of the tag marking a security group rule as
+// managed by a SecurityGroupIngress or SecurityGroupEgress resource. Its value
+// is the namespaced name of that resource. The IngressRules and EgressRules of
+// a SecurityGroup never include such a rule.
+const RuleOwnerTagKey = "ec2.services.k8s.aws/rule-owner"
+
 // addRulesToSpec updates a resource's Spec EgressRules and IngressRules
 // using data from a DescribeSecurityGroups response
 func (rm *resourceManager) addRulesToSpec(
@@ -70,8 +103,21 @@ func (rm *resourceManager) getRules(
 	ctx context.Context,
 	res *resource,
 ) (rules []*svcapitypes.SecurityGroupRule, err error) {
+	sgRules, err := rm.describeRules(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+	return rm.setResourceSecurityGroupRules(sgRules), nil
+}
+
+// describeRules calls DescribeSecurityGroupRules and returns all the rules
+// of the security group.
+func (rm *resourceManager) describeRules(
+	ctx context.Context,
+	res *resource,
+) (rules []svcsdktypes.SecurityGroupRule, err error) {
 	rlog := ackrtlog.FromContext(ctx)
-	exit := rlog.Trace("rm.getRules")
+	exit := rlog.Trace("rm.describeRules")
 	defer exit(err)
 
 	groupIDFilter := "group-id"
@@ -90,9 +136,7 @@ func (rm *resourceManager) getRules(
 		if err != nil || resp == nil {
 			break
 		}
-		for _, sgRule := range resp.SecurityGroupRules {
-			rules = append(rules, rm.setResourceSecurityGroupRule(&sgRule))
-		}
+		rules = append(rules, resp.SecurityGroupRules...)
 		if resp.NextToken == nil || *resp.NextToken == "" {
 			break
 		}
@@ -105,6 +149,110 @@ func (rm *resourceManager) getRules(
 	return rules, nil
 }
 
+// setResourceSecurityGroupRules returns the Status.Rules of a Security Group
+// given the rules returned by DescribeSecurityGroupRules.
+func (rm *resourceManager) setResourceSecurityGroupRules(
+	sgRules []svcsdktypes.SecurityGroupRule,
+) (rules []*svcapitypes.SecurityGroupRule) {
+	for _, sgRule := range sgRules {
+		rules = append(rules, rm.setResourceSecurityGroupRule(&sgRule))
+	}
+	return rules
+}
+
+// isOwnedRule returns true if the rule is managed by a SecurityGroupIngress
+// or SecurityGroupEgress resource, rather than by the IngressRules and
+// EgressRules of the SecurityGroup.
+func isOwnedRule(sgRule svcsdktypes.SecurityGroupRule) bool {
+	for _, tag := range sgRule.Tags {
+		if aws.StringValue(tag.Key) == RuleOwnerTagKey {
+			return true
+		}
+	}
+	return false
+}
+
+// removeOwnedRules removes the rules managed by SecurityGroupIngress and
+// SecurityGroupEgress resources from the Spec IngressRules and EgressRules,
+// which addRulesToSpec populates with every rule of the security group.
+// syncSGRules then never revokes them.
+func removeOwnedRules(
+	ko *svcapitypes.SecurityGroup,
+	sgRules []svcsdktypes.SecurityGroupRule,
+) {
+	for _, sgRule := range sgRules {
+		if !isOwnedRule(sgRule) {
+			continue
+		}
+		if aws.BoolValue(sgRule.IsEgress) {
+			ko.Spec.EgressRules = removeRule(ko.Spec.EgressRules, sgRule)
+		} else {
+			ko.Spec.IngressRules = removeRule(ko.Spec.IngressRules, sgRule)
+		}
+	}
+}
+
+// removeRule removes the single rule from the IPPermission that
+// DescribeSecurityGroups aggregated it into. An IPPermission left without any
+// IP range, prefix list or security group is removed.
+func removeRule(
+	rules []*svcapitypes.IPPermission,
+	sgRule svcsdktypes.SecurityGroupRule,
+) []*svcapitypes.IPPermission {
+	result := []*svcapitypes.IPPermission{}
+	for _, rule := range rules {
+		if !rulePortRangeMatches(rule, sgRule) {
+			result = append(result, rule)
+			continue
+		}
+		rule.IPRanges = slices.DeleteFunc(rule.IPRanges, func(r *svcapitypes.IPRange) bool {
+			return sgRule.CidrIpv4 != nil && aws.StringValue(r.CIDRIP) == *sgRule.CidrIpv4
+		})
+		rule.IPv6Ranges = slices.DeleteFunc(rule.IPv6Ranges, func(r *svcapitypes.IPv6Range) bool {
+			return sgRule.CidrIpv6 != nil && aws.StringValue(r.CIDRIPv6) == *sgRule.CidrIpv6
+		})
+		rule.PrefixListIDs = slices.DeleteFunc(rule.PrefixListIDs, func(p *svcapitypes.PrefixListID) bool {
+			return sgRule.PrefixListId != nil && aws.StringValue(p.PrefixListID) == *sgRule.PrefixListId
+		})
+		rule.UserIDGroupPairs = slices.DeleteFunc(rule.UserIDGroupPairs, func(pair *svcapitypes.UserIDGroupPair) bool {
+			return sgRule.ReferencedGroupInfo != nil && sgRule.ReferencedGroupInfo.GroupId != nil &&
+				aws.StringValue(pair.GroupID) == *sgRule.ReferencedGroupInfo.GroupId
+		})
+		if len(rule.IPRanges) > 0 || len(rule.IPv6Ranges) > 0 ||
+			len(rule.PrefixListIDs) > 0 || len(rule.UserIDGroupPairs) > 0 {
+			result = append(result, rule)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// rulePortRangeMatches returns true if the IPPermission has the protocol and
+// port range of the single rule. DescribeSecurityGroups omits the port range
+// of a rule that DescribeSecurityGroupRules returns as -1/-1.
+func rulePortRangeMatches(
+	rule *svcapitypes.IPPermission,
+	sgRule svcsdktypes.SecurityGroupRule,
+) bool {
+	port := func(p *int64) int64 {
+		if p == nil {
+			return -1
+		}
+		return *p
+	}
+	sgRulePort := func(p *int32) int64 {
+		if p == nil {
+			return -1
+		}
+		return int64(*p)
+	}
+	return aws.StringValue(rule.IPProtocol) == aws.StringValue(sgRule.IpProtocol) &&
+		port(rule.FromPort) == sgRulePort(sgRule.FromPort) &&
+		port(rule.ToPort) == sgRulePort(sgRule.ToPort)
+}
+
 func (rm *resourceManager) requiredFieldsMissingForSGRule(
 	r *resource,
 ) bool {
@@ -267,6 +415,13 @@ func customPostCompare(
 	}
 }
 
+// canonicalizeRule returns a copy of the rule in the form EC2 returns it on
+// read-back. CompareRules uses it to compare the single rule managed by a
+// SecurityGroupIngress or SecurityGroupEgress resource.
+func canonicalizeRule(rule *svcapitypes.IPPermission) *svcapitypes.IPPermission {
+	return canonicalizeRuleList([]*svcapitypes.IPPermission{rule.DeepCopy()}, "", "")[0]
+}
+
 // securityGroupIdentityKnown reports whether the SG's ID and owner account are
 // populated -- both required for a meaningful rule delta, and only set
 // post-create.
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -1208,3 +1209,67 @@ func TestCustomPostCompare_NilParentID_SkipsRuleDelta(t *testing.T) {
 		assert.False(t, got.egr, "rule delta must be skipped when the owner account is unknown")
 	})
 }
+
+func TestRemoveOwnedRules(t *testing.T) {
+	ownerTags := []svcsdktypes.Tag{{Key: aws.String(RuleOwnerTagKey), Value: aws.String("default/web")}}
+	ko := &svcapitypes.SecurityGroup{
+		Spec: svcapitypes.SecurityGroupSpec{
+			IngressRules: []*svcapitypes.IPPermission{
+				{
+					IPProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(443),
+					ToPort:     aws.Int64(443),
+					IPRanges: []*svcapitypes.IPRange{
+						{CIDRIP: aws.String("10.0.0.0/16")},
+						{CIDRIP: aws.String("0.0.0.0/0")},
+					},
+				},
+				{
+					IPProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(80),
+					ToPort:     aws.Int64(80),
+					UserIDGroupPairs: []*svcapitypes.UserIDGroupPair{
+						{GroupID: aws.String(testOtherID)},
+					},
+				},
+			},
+			EgressRules: []*svcapitypes.IPPermission{
+				{
+					IPProtocol: aws.String("-1"),
+					IPRanges:   []*svcapitypes.IPRange{{CIDRIP: aws.String("0.0.0.0/0")}},
+				},
+			},
+		},
+	}
+	sgRules := []svcsdktypes.SecurityGroupRule{
+		{
+			IpProtocol: aws.String("tcp"), FromPort: aws.Int32(443), ToPort: aws.Int32(443),
+			CidrIpv4: aws.String("10.0.0.0/16"),
+		},
+		{
+			IpProtocol: aws.String("tcp"), FromPort: aws.Int32(443), ToPort: aws.Int32(443),
+			CidrIpv4: aws.String("0.0.0.0/0"), Tags: ownerTags,
+		},
+		{
+			IpProtocol: aws.String("tcp"), FromPort: aws.Int32(80), ToPort: aws.Int32(80),
+			ReferencedGroupInfo: &svcsdktypes.ReferencedSecurityGroup{GroupId: aws.String(testOtherID)},
+			Tags:                ownerTags,
+		},
+		{
+			IpProtocol: aws.String("-1"), FromPort: aws.Int32(-1), ToPort: aws.Int32(-1),
+			CidrIpv4: aws.String("0.0.0.0/0"), IsEgress: aws.Bool(true), Tags: ownerTags,
+		},
+	}
+
+	removeOwnedRules(ko, sgRules)
+
+	assert.Equal(t, []*svcapitypes.IPPermission{
+		{
+			IPProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(443),
+			ToPort:     aws.Int64(443),
+			IPRanges:   []*svcapitypes.IPRange{{CIDRIP: aws.String("10.0.0.0/16")}},
+		},
+	}, ko.Spec.IngressRules)
+	assert.Nil(t, ko.Spec.EgressRules)
+}
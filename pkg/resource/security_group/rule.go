@@ -0,0 +1,216 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_group
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+// Rule holds the Spec fields of a SecurityGroupIngress or SecurityGroupEgress
+// resource, which each describe a single security group rule.
+type Rule struct {
+	IPProtocol        *string
+	FromPort          *int64
+	ToPort            *int64
+	CIDRIPv4          *string
+	CIDRIPv6          *string
+	PrefixListID      *string
+	ReferencedGroupID *string
+	Description       *string
+}
+
+// NewRuleOwnerTag returns the RuleOwnerTagKey tag marking a rule as managed by
+// the resource with the supplied namespace and name, so that the SecurityGroup
+// resource managing its security group never revokes it.
+func NewRuleOwnerTag(namespace string, name string) *svcapitypes.Tag {
+	return &svcapitypes.Tag{
+		Key:   aws.String(RuleOwnerTagKey),
+		Value: aws.String(types.NamespacedName{Namespace: namespace, Name: name}.String()),
+	}
+}
+
+// RemoveRuleOwnerTag returns the tags without the RuleOwnerTagKey tag, which
+// is not part of the Spec of a rule.
+func RemoveRuleOwnerTag(tags []*svcapitypes.Tag) []*svcapitypes.Tag {
+	var result []*svcapitypes.Tag
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) != RuleOwnerTagKey {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// NewRuleTagSpecifications returns the TagSpecifications of the request
+// authorizing a rule, holding the Spec tags and the supplied owner tag.
+func NewRuleTagSpecifications(
+	specTags []*svcapitypes.Tag,
+	ownerTag *svcapitypes.Tag,
+) []svcsdktypes.TagSpecification {
+	requestedTags := []svcsdktypes.Tag{}
+	for _, tag := range append(RemoveRuleOwnerTag(specTags), ownerTag) {
+		requestedTags = append(requestedTags, svcsdktypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return []svcsdktypes.TagSpecification{{
+		ResourceType: svcsdktypes.ResourceTypeSecurityGroupRule,
+		Tags:         requestedTags,
+	}}
+}
+
+// NewIPPermission returns the IpPermission of the rule.
+func NewIPPermission(rule Rule) svcsdktypes.IpPermission {
+	perm := svcsdktypes.IpPermission{
+		IpProtocol: rule.IPProtocol,
+		FromPort:   toInt32Ptr(rule.FromPort),
+		ToPort:     toInt32Ptr(rule.ToPort),
+	}
+	switch {
+	case rule.CIDRIPv4 != nil:
+		perm.IpRanges = []svcsdktypes.IpRange{{
+			CidrIp:      rule.CIDRIPv4,
+			Description: rule.Description,
+		}}
+	case rule.CIDRIPv6 != nil:
+		perm.Ipv6Ranges = []svcsdktypes.Ipv6Range{{
+			CidrIpv6:    rule.CIDRIPv6,
+			Description: rule.Description,
+		}}
+	case rule.PrefixListID != nil:
+		perm.PrefixListIds = []svcsdktypes.PrefixListId{{
+			PrefixListId: rule.PrefixListID,
+			Description:  rule.Description,
+		}}
+	case rule.ReferencedGroupID != nil:
+		perm.UserIdGroupPairs = []svcsdktypes.UserIdGroupPair{{
+			GroupId:     rule.ReferencedGroupID,
+			Description: rule.Description,
+		}}
+	}
+	return perm
+}
+
+// NewModifySecurityGroupRulesInput returns the ModifySecurityGroupRules input
+// setting the rule with the supplied ID in the supplied security group to the
+// supplied rule.
+func NewModifySecurityGroupRulesInput(
+	groupID *string,
+	ruleID *string,
+	rule Rule,
+) *svcsdk.ModifySecurityGroupRulesInput {
+	return &svcsdk.ModifySecurityGroupRulesInput{
+		GroupId: groupID,
+		SecurityGroupRules: []svcsdktypes.SecurityGroupRuleUpdate{{
+			SecurityGroupRuleId: ruleID,
+			SecurityGroupRule: &svcsdktypes.SecurityGroupRuleRequest{
+				CidrIpv4:          rule.CIDRIPv4,
+				CidrIpv6:          rule.CIDRIPv6,
+				Description:       rule.Description,
+				FromPort:          toInt32Ptr(rule.FromPort),
+				IpProtocol:        rule.IPProtocol,
+				PrefixListId:      rule.PrefixListID,
+				ReferencedGroupId: rule.ReferencedGroupID,
+				ToPort:            toInt32Ptr(rule.ToPort),
+			},
+		}},
+	}
+}
+
+// CompareRules compares the protocol, port range and CIDR ranges of two rules,
+// which the generated delta of a rule resource skips, in the form EC2 returns
+// them on read-back. A numeric protocol or a CIDR with host bits set then
+// never drives a spurious diff.
+func CompareRules(delta *ackcompare.Delta, a Rule, b Rule) {
+	ruleA := canonicalizeRule(a.ipPermission())
+	ruleB := canonicalizeRule(b.ipPermission())
+	if !equality.Semantic.DeepEqual(ruleA.IPProtocol, ruleB.IPProtocol) {
+		delta.Add("Spec.IPProtocol", a.IPProtocol, b.IPProtocol)
+	}
+	if !equality.Semantic.DeepEqual(ruleA.FromPort, ruleB.FromPort) {
+		delta.Add("Spec.FromPort", a.FromPort, b.FromPort)
+	}
+	if !equality.Semantic.DeepEqual(ruleA.ToPort, ruleB.ToPort) {
+		delta.Add("Spec.ToPort", a.ToPort, b.ToPort)
+	}
+	if !equality.Semantic.DeepEqual(ruleA.IPRanges, ruleB.IPRanges) {
+		delta.Add("Spec.CIDRIPv4", a.CIDRIPv4, b.CIDRIPv4)
+	}
+	if !equality.Semantic.DeepEqual(ruleA.IPv6Ranges, ruleB.IPv6Ranges) {
+		delta.Add("Spec.CIDRIPv6", a.CIDRIPv6, b.CIDRIPv6)
+	}
+}
+
+// ipPermission returns the fields of the rule that EC2 normalises, in the
+// shape canonicalizeRule expects.
+func (rule Rule) ipPermission() *svcapitypes.IPPermission {
+	perm := &svcapitypes.IPPermission{
+		IPProtocol: rule.IPProtocol,
+		FromPort:   rule.FromPort,
+		ToPort:     rule.ToPort,
+	}
+	if rule.CIDRIPv4 != nil {
+		perm.IPRanges = []*svcapitypes.IPRange{{CIDRIP: rule.CIDRIPv4}}
+	}
+	if rule.CIDRIPv6 != nil {
+		perm.IPv6Ranges = []*svcapitypes.IPv6Range{{CIDRIPv6: rule.CIDRIPv6}}
+	}
+	return perm
+}
+
+// tagsClient is the part of the EC2 API used to sync the tags of a rule.
+type tagsClient interface {
+	CreateTags(context.Context, *svcsdk.CreateTagsInput, ...func(*svcsdk.Options)) (*svcsdk.CreateTagsOutput, error)
+	DescribeTags(context.Context, *svcsdk.DescribeTagsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeTagsOutput, error)
+	DeleteTags(context.Context, *svcsdk.DeleteTagsInput, ...func(*svcsdk.Options)) (*svcsdk.DeleteTagsOutput, error)
+}
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+// SyncRuleTags syncs the tags of the rule with the supplied ID with the
+// desired Spec tags, always keeping the supplied owner tag.
+func SyncRuleTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	ruleID string,
+	ownerTag *svcapitypes.Tag,
+	desiredTags []*svcapitypes.Tag,
+	latestTags []*svcapitypes.Tag,
+) error {
+	// The owner tag is never part of the latest Spec tags, so that a rule
+	// that was adopted, or whose owner tag was removed, is marked again.
+	desiredTags = append(RemoveRuleOwnerTag(desiredTags), ownerTag)
+	return tags.Sync(ctx, client, mr, ruleID, desiredTags, latestTags)
+}
+
+func toInt32Ptr(i *int64) *int32 {
+	if i == nil {
+		return nil
+	}
+	return aws.Int32(int32(*i))
+}
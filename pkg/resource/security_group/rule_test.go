@@ -0,0 +1,153 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_group
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+)
+
+func TestNewIPPermission(t *testing.T) {
+	tt := []struct {
+		id       string
+		rule     Rule
+		expected svcsdktypes.IpPermission
+	}{
+		{"cidr block",
+			Rule{
+				IPProtocol: aws.String("tcp"), FromPort: aws.Int64(443), ToPort: aws.Int64(443),
+				CIDRIPv4: aws.String("0.0.0.0/0"), Description: aws.String("https"),
+			},
+			svcsdktypes.IpPermission{
+				IpProtocol: aws.String("tcp"), FromPort: aws.Int32(443), ToPort: aws.Int32(443),
+				IpRanges: []svcsdktypes.IpRange{{CidrIp: aws.String("0.0.0.0/0"), Description: aws.String("https")}},
+			},
+		},
+		{"referenced group",
+			Rule{
+				IPProtocol: aws.String("-1"), ReferencedGroupID: aws.String("sg-1"),
+			},
+			svcsdktypes.IpPermission{
+				IpProtocol:       aws.String("-1"),
+				UserIdGroupPairs: []svcsdktypes.UserIdGroupPair{{GroupId: aws.String("sg-1")}},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewIPPermission(tc.rule))
+		})
+	}
+}
+
+func TestCompareRules(t *testing.T) {
+	tt := []struct {
+		id       string
+		desired  Rule
+		latest   Rule
+		expected []string
+	}{
+		{"numeric protocol",
+			Rule{IPProtocol: aws.String("6"), FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+			Rule{IPProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+			nil,
+		},
+		{"all traffic without ports",
+			Rule{IPProtocol: aws.String("-1"), CIDRIPv4: aws.String("10.0.0.1/16")},
+			Rule{IPProtocol: aws.String("-1"), FromPort: aws.Int64(-1), ToPort: aws.Int64(-1), CIDRIPv4: aws.String("10.0.0.0/16")},
+			nil,
+		},
+		{"changed port range",
+			Rule{IPProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(81)},
+			Rule{IPProtocol: aws.String("tcp"), FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+			[]string{"Spec.ToPort"},
+		},
+		{"changed cidr block",
+			Rule{IPProtocol: aws.String("-1"), CIDRIPv6: aws.String("::/0")},
+			Rule{IPProtocol: aws.String("-1"), CIDRIPv4: aws.String("0.0.0.0/0")},
+			[]string{"Spec.CIDRIPv4", "Spec.CIDRIPv6"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			CompareRules(delta, tc.desired, tc.latest)
+			var paths []string
+			for _, path := range []string{"Spec.CIDRIPv4", "Spec.CIDRIPv6", "Spec.FromPort", "Spec.IPProtocol", "Spec.ToPort"} {
+				if delta.DifferentAt(path) {
+					paths = append(paths, path)
+				}
+			}
+			assert.Equal(t, tc.expected, paths)
+		})
+	}
+}
+
+func TestRemoveRuleOwnerTag(t *testing.T) {
+	tags := []*svcapitypes.Tag{
+		{Key: aws.String("team"), Value: aws.String("web")},
+		NewRuleOwnerTag("default", "web"),
+	}
+	assert.Equal(t, tags[:1], RemoveRuleOwnerTag(tags))
+	assert.Nil(t, RemoveRuleOwnerTag(tags[1:]))
+}
+
+type fakeTagsClient struct {
+	created []svcsdktypes.Tag
+	deleted []svcsdktypes.Tag
+}
+
+func (c *fakeTagsClient) CreateTags(_ context.Context, input *svcsdk.CreateTagsInput, _ ...func(*svcsdk.Options)) (*svcsdk.CreateTagsOutput, error) {
+	c.created = append(c.created, input.Tags...)
+	return &svcsdk.CreateTagsOutput{}, nil
+}
+
+func (c *fakeTagsClient) DescribeTags(context.Context, *svcsdk.DescribeTagsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeTagsOutput, error) {
+	return &svcsdk.DescribeTagsOutput{}, nil
+}
+
+func (c *fakeTagsClient) DeleteTags(_ context.Context, input *svcsdk.DeleteTagsInput, _ ...func(*svcsdk.Options)) (*svcsdk.DeleteTagsOutput, error) {
+	c.deleted = append(c.deleted, input.Tags...)
+	return &svcsdk.DeleteTagsOutput{}, nil
+}
+
+type fakeMetricsRecorder struct{}
+
+func (fakeMetricsRecorder) RecordAPICall(string, string, error) {}
+
+func TestSyncRuleTags(t *testing.T) {
+	desiredTags := []*svcapitypes.Tag{{Key: aws.String("team"), Value: aws.String("web")}}
+	// The owner tag is filtered out of the latest Spec tags on read.
+	latestTags := []*svcapitypes.Tag{{Key: aws.String("stale"), Value: aws.String("tag")}}
+
+	client := &fakeTagsClient{}
+	err := SyncRuleTags(
+		context.TODO(), client, fakeMetricsRecorder{}, "sgr-1",
+		NewRuleOwnerTag("default", "web"), desiredTags, latestTags,
+	)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []svcsdktypes.Tag{
+		{Key: aws.String("team"), Value: aws.String("web")},
+		{Key: aws.String("ec2.services.k8s.aws/rule-owner"), Value: aws.String("default/web")},
+	}, client.created)
+	assert.Equal(t, []svcsdktypes.Tag{{Key: aws.String("stale"), Value: aws.String("tag")}}, client.deleted)
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_group_egress
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/security_group"
+)
+
+// checkForMissingRequiredFields returns true if the rule has not been
+// created yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.SecurityGroupRuleID == nil
+}
+
+// newRule returns the single rule described by the resource.
+func newRule(ko *svcapitypes.SecurityGroupEgress) security_group.Rule {
+	return security_group.Rule{
+		IPProtocol:        ko.Spec.IPProtocol,
+		FromPort:          ko.Spec.FromPort,
+		ToPort:            ko.Spec.ToPort,
+		CIDRIPv4:          ko.Spec.CIDRIPv4,
+		CIDRIPv6:          ko.Spec.CIDRIPv6,
+		PrefixListID:      ko.Spec.PrefixListID,
+		ReferencedGroupID: ko.Spec.ReferencedGroupID,
+		Description:       ko.Spec.Description,
+	}
+}
+
+// newOwnerTag returns the tag marking the rule as managed by the resource.
+func newOwnerTag(ko *svcapitypes.SecurityGroupEgress) *svcapitypes.Tag {
+	return security_group.NewRuleOwnerTag(ko.Namespace, ko.Name)
+}
+
+// removeOwnerTag is used by the sdk_read_many_post_set_output hook, as the
+// generated sdk.go does not import the security_group package.
+var removeOwnerTag = security_group.RemoveRuleOwnerTag
+
+// updateIPPermissionsInCreateRequest sets the single rule described by the
+// resource in the AuthorizeSecurityGroupEgress request.
+func updateIPPermissionsInCreateRequest(
+	r *resource,
+	input *svcsdk.AuthorizeSecurityGroupEgressInput,
+) {
+	input.IpPermissions = []svcsdktypes.IpPermission{security_group.NewIPPermission(newRule(r.ko))}
+}
+
+// updateTagSpecificationsInCreateRequest adds the Tags defined in the Spec
+// and the owner tag of the rule to the AuthorizeSecurityGroupEgress request.
+func updateTagSpecificationsInCreateRequest(
+	r *resource,
+	input *svcsdk.AuthorizeSecurityGroupEgressInput,
+) {
+	input.TagSpecifications = security_group.NewRuleTagSpecifications(r.ko.Spec.Tags, newOwnerTag(r.ko))
+}
+
+// customPostCompare compares the protocol, port range and CIDR ranges of the
+// rule, which the generated delta skips, in the form EC2 returns them on
+// read-back.
+func customPostCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	security_group.CompareRules(delta, newRule(a.ko), newRule(b.ko))
+}
+
+// customUpdateSecurityGroupEgress modifies the rule in place and syncs its
+// tags. The security group of a rule is immutable.
+func (rm *resourceManager) customUpdateSecurityGroupEgress(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateSecurityGroupEgress")
+	defer func() {
+		exit(err)
+	}()
+
+	if delta.DifferentExcept("Spec.Tags") {
+		input := security_group.NewModifySecurityGroupRulesInput(
+			desired.ko.Spec.GroupID, latest.ko.Status.SecurityGroupRuleID, newRule(desired.ko),
+		)
+		_, err = rm.sdkapi.ModifySecurityGroupRules(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "ModifySecurityGroupRules", err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = security_group.SyncRuleTags(
+		ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.SecurityGroupRuleID,
+		newOwnerTag(desired.ko), desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	); err != nil {
+		return nil, err
+	}
+	return rm.concreteResource(desired.DeepCopy()), nil
+}
@@ -105,6 +105,23 @@ func newResourceDelta(
 			delta.Add("Spec.HostnameType", a.ko.Spec.HostnameType, b.ko.Spec.HostnameType)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.IPv4IPAMPoolID, b.ko.Spec.IPv4IPAMPoolID) {
+		delta.Add("Spec.IPv4IPAMPoolID", a.ko.Spec.IPv4IPAMPoolID, b.ko.Spec.IPv4IPAMPoolID)
+	} else if a.ko.Spec.IPv4IPAMPoolID != nil && b.ko.Spec.IPv4IPAMPoolID != nil {
+		if *a.ko.Spec.IPv4IPAMPoolID != *b.ko.Spec.IPv4IPAMPoolID {
+			delta.Add("Spec.IPv4IPAMPoolID", a.ko.Spec.IPv4IPAMPoolID, b.ko.Spec.IPv4IPAMPoolID)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.IPv4IPAMPoolRef, b.ko.Spec.IPv4IPAMPoolRef) {
+		delta.Add("Spec.IPv4IPAMPoolRef", a.ko.Spec.IPv4IPAMPoolRef, b.ko.Spec.IPv4IPAMPoolRef)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.IPv4NetmaskLength, b.ko.Spec.IPv4NetmaskLength) {
+		delta.Add("Spec.IPv4NetmaskLength", a.ko.Spec.IPv4NetmaskLength, b.ko.Spec.IPv4NetmaskLength)
+	} else if a.ko.Spec.IPv4NetmaskLength != nil && b.ko.Spec.IPv4NetmaskLength != nil {
+		if *a.ko.Spec.IPv4NetmaskLength != *b.ko.Spec.IPv4NetmaskLength {
+			delta.Add("Spec.IPv4NetmaskLength", a.ko.Spec.IPv4NetmaskLength, b.ko.Spec.IPv4NetmaskLength)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IPv6CIDRBlock, b.ko.Spec.IPv6CIDRBlock) {
 		delta.Add("Spec.IPv6CIDRBlock", a.ko.Spec.IPv6CIDRBlock, b.ko.Spec.IPv6CIDRBlock)
 	} else if a.ko.Spec.IPv6CIDRBlock != nil && b.ko.Spec.IPv6CIDRBlock != nil {
@@ -112,6 +129,16 @@ func newResourceDelta(
 			delta.Add("Spec.IPv6CIDRBlock", a.ko.Spec.IPv6CIDRBlock, b.ko.Spec.IPv6CIDRBlock)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.IPv6IPAMPoolID, b.ko.Spec.IPv6IPAMPoolID) {
+		delta.Add("Spec.IPv6IPAMPoolID", a.ko.Spec.IPv6IPAMPoolID, b.ko.Spec.IPv6IPAMPoolID)
+	} else if a.ko.Spec.IPv6IPAMPoolID != nil && b.ko.Spec.IPv6IPAMPoolID != nil {
+		if *a.ko.Spec.IPv6IPAMPoolID != *b.ko.Spec.IPv6IPAMPoolID {
+			delta.Add("Spec.IPv6IPAMPoolID", a.ko.Spec.IPv6IPAMPoolID, b.ko.Spec.IPv6IPAMPoolID)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.IPv6IPAMPoolRef, b.ko.Spec.IPv6IPAMPoolRef) {
+		delta.Add("Spec.IPv6IPAMPoolRef", a.ko.Spec.IPv6IPAMPoolRef, b.ko.Spec.IPv6IPAMPoolRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IPv6Native, b.ko.Spec.IPv6Native) {
 		delta.Add("Spec.IPv6Native", a.ko.Spec.IPv6Native, b.ko.Spec.IPv6Native)
 	} else if a.ko.Spec.IPv6Native != nil && b.ko.Spec.IPv6Native != nil {
@@ -119,6 +146,13 @@ func newResourceDelta(
 			delta.Add("Spec.IPv6Native", a.ko.Spec.IPv6Native, b.ko.Spec.IPv6Native)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.IPv6NetmaskLength, b.ko.Spec.IPv6NetmaskLength) {
+		delta.Add("Spec.IPv6NetmaskLength", a.ko.Spec.IPv6NetmaskLength, b.ko.Spec.IPv6NetmaskLength)
+	} else if a.ko.Spec.IPv6NetmaskLength != nil && b.ko.Spec.IPv6NetmaskLength != nil {
+		if *a.ko.Spec.IPv6NetmaskLength != *b.ko.Spec.IPv6NetmaskLength {
+			delta.Add("Spec.IPv6NetmaskLength", a.ko.Spec.IPv6NetmaskLength, b.ko.Spec.IPv6NetmaskLength)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.MapPublicIPOnLaunch, b.ko.Spec.MapPublicIPOnLaunch) {
 		delta.Add("Spec.MapPublicIPOnLaunch", a.ko.Spec.MapPublicIPOnLaunch, b.ko.Spec.MapPublicIPOnLaunch)
 	} else if a.ko.Spec.MapPublicIPOnLaunch != nil && b.ko.Spec.MapPublicIPOnLaunch != nil {
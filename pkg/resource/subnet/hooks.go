@@ -15,14 +15,24 @@ package subnet
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	ackutils "github.com/aws-controllers-k8s/runtime/pkg/util"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
 
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/resource/vpc"
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
 )
 
@@ -60,6 +70,21 @@ func (rm *resourceManager) customUpdateSubnet(
 		}
 	}
 
+	// The IPv6 CIDR block is synced before the attributes, as
+	// AssignIPv6AddressOnCreation requires the subnet to have one.
+	if delta.DifferentAt("Spec.IPv6CIDRBlock") {
+		if isIPv6CIDRBlockSyncing(latest) {
+			return nil, requeueWaitWhileIPv6CIDRBlockSyncing
+		}
+		if err = rm.syncIPv6CIDRBlock(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// The association is asynchronous; requeue until the association
+		// set has settled.
+		updated.ko.Status.IPv6CIDRBlockAssociationSet = latest.ko.Status.IPv6CIDRBlockAssociationSet
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, nil, nil)
+	}
+
 	// These fields must be edited one at a time
 	if delta.DifferentAt("Spec.AssignIPv6AddressOnCreation") {
 		if err = rm.updateSubnetAttribute(ctx, desired, "AssignIPv6AddressOnCreation"); err != nil {
@@ -349,6 +374,367 @@ func toStrPtr(str string) *string {
 	return &str
 }
 
+// setAllocatedCIDRBlock records the CIDR allocated from an IPv4 IPAM pool in
+// Status.AllocatedCIDRBlock. Spec.CIDRBlock is kept as desired so the
+// allocated CIDR is not treated as drift.
+func setAllocatedCIDRBlock(
+	desired *resource,
+	ko *svcapitypes.Subnet,
+) {
+	if desired.ko.Spec.IPv4IPAMPoolID == nil {
+		return
+	}
+	ko.Status.AllocatedCIDRBlock = ko.Spec.CIDRBlock
+	if desired.ko.Spec.CIDRBlock == nil {
+		ko.Spec.CIDRBlock = nil
+	}
+}
+
+var (
+	ErrIPv6CIDRBlockSyncing = fmt.Errorf(
+		"Subnet has an IPv6 CIDR block in transitional state, cannot be modified",
+	)
+	requeueWaitWhileIPv6CIDRBlockSyncing = ackrequeue.NeededAfter(
+		ErrIPv6CIDRBlockSyncing,
+		5*time.Second,
+	)
+)
+
+// isIPv6CIDRBlockSyncing returns true if any IPv6 CIDR block is in a
+// transitional state (associating, disassociating).
+func isIPv6CIDRBlockSyncing(r *resource) bool {
+	for _, assoc := range r.ko.Status.IPv6CIDRBlockAssociationSet {
+		if assoc.IPv6CIDRBlockState == nil || assoc.IPv6CIDRBlockState.State == nil {
+			continue
+		}
+		switch svcsdktypes.SubnetCidrBlockStateCode(*assoc.IPv6CIDRBlockState.State) {
+		case svcsdktypes.SubnetCidrBlockStateCodeAssociating,
+			svcsdktypes.SubnetCidrBlockStateCodeDisassociating:
+			return true
+		}
+	}
+	return false
+}
+
+// activeIPv6CIDRBlockAssociation returns the IPv6 CIDR block association that
+// is associated or being associated with the subnet, if any. A subnet has at
+// most one such association.
+func activeIPv6CIDRBlockAssociation(
+	assocs []*svcapitypes.SubnetIPv6CIDRBlockAssociation,
+) *svcapitypes.SubnetIPv6CIDRBlockAssociation {
+	for _, assoc := range assocs {
+		if assoc.IPv6CIDRBlockState == nil || assoc.IPv6CIDRBlockState.State == nil {
+			continue
+		}
+		switch svcsdktypes.SubnetCidrBlockStateCode(*assoc.IPv6CIDRBlockState.State) {
+		case svcsdktypes.SubnetCidrBlockStateCodeAssociated,
+			svcsdktypes.SubnetCidrBlockStateCodeAssociating:
+			return assoc
+		}
+	}
+	return nil
+}
+
+// setIPv6CIDRBlock sets Spec.IPv6CIDRBlock to the IPv6 CIDR block currently
+// associated with the subnet, so adding or changing it is seen as a
+// difference. When the desired IPv6CIDRBlock is not set, the IPv6 CIDR block
+// is left unmanaged, so that the block of an adopted subnet or a block
+// allocated from an IPv6 IPAM pool is not disassociated. Such blocks are only
+// reported in Status.IPv6CIDRBlockAssociationSet.
+func setIPv6CIDRBlock(
+	desired *resource,
+	ko *svcapitypes.Subnet,
+) {
+	if desired.ko.Spec.IPv6CIDRBlock == nil {
+		return
+	}
+	ko.Spec.IPv6CIDRBlock = nil
+	assoc := activeIPv6CIDRBlockAssociation(ko.Status.IPv6CIDRBlockAssociationSet)
+	if assoc == nil || assoc.IPv6CIDRBlock == nil {
+		return
+	}
+	ko.Spec.IPv6CIDRBlock = assoc.IPv6CIDRBlock
+	// Keep the desired notation when it is the same block written
+	// differently, e.g. with leading zeros.
+	if vpc.SameCIDRBlock(*desired.ko.Spec.IPv6CIDRBlock, *assoc.IPv6CIDRBlock) {
+		ko.Spec.IPv6CIDRBlock = desired.ko.Spec.IPv6CIDRBlock
+	}
+}
+
+// syncIPv6CIDRBlock replaces the IPv6 CIDR block associated with the subnet
+// by the desired one. A subnet can only have one IPv6 CIDR block, so a
+// changed block is first disassociated and the new block is associated once
+// the disassociation has completed.
+func (rm *resourceManager) syncIPv6CIDRBlock(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncIPv6CIDRBlock")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	if assoc := activeIPv6CIDRBlockAssociation(latest.ko.Status.IPv6CIDRBlockAssociationSet); assoc != nil {
+		rlog.Debug("disassociating IPv6 CIDR block from subnet", "ipv6_cidr_block", *assoc.IPv6CIDRBlock)
+		return rm.disassociateIPv6CIDRBlock(ctx, *assoc.AssociationID)
+	}
+	if desired.ko.Spec.IPv6CIDRBlock != nil {
+		rlog.Debug("associating IPv6 CIDR block with subnet", "ipv6_cidr_block", *desired.ko.Spec.IPv6CIDRBlock)
+		return rm.associateIPv6CIDRBlock(ctx, *latest.ko.Status.SubnetID, *desired.ko.Spec.IPv6CIDRBlock)
+	}
+	return nil
+}
+
+// associateIPv6CIDRBlock associates an IPv6 CIDR block with the subnet
+func (rm *resourceManager) associateIPv6CIDRBlock(
+	ctx context.Context,
+	subnetID string,
+	cidrBlock string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.associateIPv6CIDRBlock")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.AssociateSubnetCidrBlockInput{
+		SubnetId:      &subnetID,
+		Ipv6CidrBlock: &cidrBlock,
+	}
+
+	_, err = rm.sdkapi.AssociateSubnetCidrBlock(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AssociateSubnetCidrBlock", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// disassociateIPv6CIDRBlock disassociates an IPv6 CIDR block from the subnet
+// based on its association ID
+func (rm *resourceManager) disassociateIPv6CIDRBlock(
+	ctx context.Context,
+	associationID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disassociateIPv6CIDRBlock")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DisassociateSubnetCidrBlockInput{
+		AssociationId: &associationID,
+	}
+
+	_, err = rm.sdkapi.DisassociateSubnetCidrBlock(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DisassociateSubnetCidrBlock", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	ErrNoFreeCIDRBlock = fmt.Errorf(
+		"VPC has no free CIDR block for the requested netmask length",
+	)
+	requeueWaitForFreeCIDRBlock = ackrequeue.NeededAfter(
+		ErrNoFreeCIDRBlock,
+		30*time.Second,
+	)
+)
+
+// cidrBlockLocks serializes picking a CIDR block and creating the subnet per
+// VPC, so concurrent reconciles of Subnets in the same VPC never pick the
+// same block. Conflicts with subnets created outside of this controller are
+// reported by CreateSubnet as InvalidSubnet.Conflict; the create is then
+// retried and the next free block is picked.
+var (
+	cidrBlockLocksMu sync.Mutex
+	cidrBlockLocks   = map[string]*sync.Mutex{}
+)
+
+// lockVPCCIDRBlocks locks the CIDR blocks of the given VPC and returns the
+// function releasing the lock.
+func lockVPCCIDRBlocks(vpcID string) func() {
+	cidrBlockLocksMu.Lock()
+	l, ok := cidrBlockLocks[vpcID]
+	if !ok {
+		l = &sync.Mutex{}
+		cidrBlockLocks[vpcID] = l
+	}
+	cidrBlockLocksMu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// needsCIDRBlockFromVPC returns true if the subnet asks for a netmask length
+// instead of a CIDR block, without an IPv4 IPAM pool to allocate it from.
+func needsCIDRBlockFromVPC(r *resource) bool {
+	return r.ko.Spec.CIDRBlock == nil &&
+		r.ko.Spec.IPv4IPAMPoolID == nil &&
+		r.ko.Spec.IPv4NetmaskLength != nil
+}
+
+// setCIDRBlockFromVPC picks the first free block of the requested netmask
+// length in the subnet's VPC and sets it in the create request. The caller
+// must hold the lock returned by lockVPCCIDRBlocks until the subnet is
+// created.
+func (rm *resourceManager) setCIDRBlockFromVPC(
+	ctx context.Context,
+	r *resource,
+	input *svcsdk.CreateSubnetInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setCIDRBlockFromVPC")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	vpcCIDRBlocks, err := rm.getVPCCIDRBlocks(ctx, *r.ko.Spec.VPCID)
+	if err != nil {
+		return err
+	}
+	subnetCIDRBlocks, err := rm.getSubnetCIDRBlocks(ctx, *r.ko.Spec.VPCID)
+	if err != nil {
+		return err
+	}
+
+	cidrBlock, found := firstFreeCIDRBlock(
+		vpcCIDRBlocks, subnetCIDRBlocks, int(*r.ko.Spec.IPv4NetmaskLength),
+	)
+	if !found {
+		return requeueWaitForFreeCIDRBlock
+	}
+	rlog.Debug("picked free CIDR block from VPC", "cidr_block", cidrBlock)
+
+	// The netmask length is only accepted by CreateSubnet together with an
+	// IPAM pool.
+	input.CidrBlock = aws.String(cidrBlock)
+	input.Ipv4NetmaskLength = nil
+	return nil
+}
+
+// getVPCCIDRBlocks returns the IPv4 CIDR blocks associated with the VPC, the
+// same blocks listed in the VPC's Status.CIDRBlockAssociationSet.
+func (rm *resourceManager) getVPCCIDRBlocks(
+	ctx context.Context,
+	vpcID string,
+) ([]string, error) {
+	resp, err := rm.sdkapi.DescribeVpcs(ctx, &svcsdk.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeVpcs", err)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrBlocks := []string{}
+	for _, vpc := range resp.Vpcs {
+		for _, assoc := range vpc.CidrBlockAssociationSet {
+			if assoc.CidrBlock == nil || assoc.CidrBlockState == nil ||
+				assoc.CidrBlockState.State != svcsdktypes.VpcCidrBlockStateCodeAssociated {
+				continue
+			}
+			cidrBlocks = append(cidrBlocks, *assoc.CidrBlock)
+		}
+	}
+	return cidrBlocks, nil
+}
+
+// getSubnetCIDRBlocks returns the IPv4 CIDR blocks of all subnets in the VPC.
+func (rm *resourceManager) getSubnetCIDRBlocks(
+	ctx context.Context,
+	vpcID string,
+) ([]string, error) {
+	input := &svcsdk.DescribeSubnetsInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   toStrPtr("vpc-id"),
+				Values: []string{vpcID},
+			},
+		},
+	}
+
+	cidrBlocks := []string{}
+	paginator := svcsdk.NewDescribeSubnetsPaginator(rm.sdkapi, input)
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeSubnets", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range resp.Subnets {
+			if subnet.CidrBlock != nil {
+				cidrBlocks = append(cidrBlocks, *subnet.CidrBlock)
+			}
+		}
+	}
+	return cidrBlocks, nil
+}
+
+// firstFreeCIDRBlock returns the lowest block of the given netmask length
+// inside the VPC CIDR blocks that does not overlap any of the subnet CIDR
+// blocks. VPC CIDR blocks are walked in address order, so the same inputs
+// always return the same block.
+func firstFreeCIDRBlock(
+	vpcCIDRBlocks []string,
+	subnetCIDRBlocks []string,
+	netmaskLength int,
+) (string, bool) {
+	used := []netip.Prefix{}
+	for _, c := range subnetCIDRBlocks {
+		if p, err := netip.ParsePrefix(c); err == nil {
+			used = append(used, p.Masked())
+		}
+	}
+
+	vpcPrefixes := []netip.Prefix{}
+	for _, c := range vpcCIDRBlocks {
+		if p, err := netip.ParsePrefix(c); err == nil && p.Addr().Is4() {
+			vpcPrefixes = append(vpcPrefixes, p.Masked())
+		}
+	}
+	sort.Slice(vpcPrefixes, func(i, j int) bool {
+		return vpcPrefixes[i].Addr().Less(vpcPrefixes[j].Addr())
+	})
+
+	for _, vpcPrefix := range vpcPrefixes {
+		if netmaskLength < vpcPrefix.Bits() || netmaskLength > 32 {
+			continue
+		}
+		start := vpcPrefix.Addr().As4()
+		base := uint64(start[0])<<24 | uint64(start[1])<<16 | uint64(start[2])<<8 | uint64(start[3])
+		size := uint64(1) << (32 - netmaskLength)
+		count := uint64(1) << (netmaskLength - vpcPrefix.Bits())
+		for i := uint64(0); i < count; i++ {
+			addr := base + i*size
+			candidate := netip.PrefixFrom(netip.AddrFrom4([4]byte{
+				byte(addr >> 24), byte(addr >> 16), byte(addr >> 8), byte(addr),
+			}), netmaskLength)
+			if !overlapsAny(candidate, used) {
+				return candidate.String(), true
+			}
+		}
+	}
+	return "", false
+}
+
+// overlapsAny returns true if the prefix overlaps any of the given prefixes.
+func overlapsAny(p netip.Prefix, prefixes []netip.Prefix) bool {
+	for _, other := range prefixes {
+		if p.Overlaps(other) {
+			return true
+		}
+	}
+	return false
+}
+
 // updateTagSpecificationsInCreateRequest adds
 // Tags defined in the Spec to CreateSubnetInput.TagSpecification
 // and ensures the ResourceType is always set to 'subnet'
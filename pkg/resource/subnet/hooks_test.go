@@ -0,0 +1,197 @@
+package subnet
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAllocatedCIDRBlock(t *testing.T) {
+	tt := []struct {
+		id                string
+		desiredPoolID     *string
+		desiredCIDRBlock  *string
+		expectedCIDRBlock *string
+		expectedAllocated *string
+	}{
+		{"no IPAM pool",
+			nil, aws.String("10.0.0.0/24"),
+			aws.String("10.0.0.0/24"), nil,
+		},
+		{"allocated from IPAM pool",
+			aws.String("ipam-pool-1"), nil,
+			nil, aws.String("10.0.0.0/24"),
+		},
+		{"explicit CIDR from IPAM pool",
+			aws.String("ipam-pool-1"), aws.String("10.0.0.0/24"),
+			aws.String("10.0.0.0/24"), aws.String("10.0.0.0/24"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			desired := &resource{ko: &svcapitypes.Subnet{
+				Spec: svcapitypes.SubnetSpec{
+					CIDRBlock:      tc.desiredCIDRBlock,
+					IPv4IPAMPoolID: tc.desiredPoolID,
+				},
+			}}
+			ko := desired.ko.DeepCopy()
+			ko.Spec.CIDRBlock = aws.String("10.0.0.0/24")
+
+			setAllocatedCIDRBlock(desired, ko)
+			assert.Equal(t, tc.expectedCIDRBlock, ko.Spec.CIDRBlock)
+			assert.Equal(t, tc.expectedAllocated, ko.Status.AllocatedCIDRBlock)
+
+			delta := newResourceDelta(desired, &resource{ko})
+			assert.False(t, delta.DifferentAt("Spec.CIDRBlock"))
+		})
+	}
+}
+
+func TestFirstFreeCIDRBlock(t *testing.T) {
+	tt := []struct {
+		id            string
+		vpcCIDRBlocks []string
+		subnets       []string
+		netmaskLength int
+		expected      string
+		found         bool
+	}{
+		{"empty VPC",
+			[]string{"10.0.0.0/16"}, nil, 24,
+			"10.0.0.0/24", true,
+		},
+		{"skips used blocks",
+			[]string{"10.0.0.0/16"}, []string{"10.0.0.0/24", "10.0.1.0/24"}, 24,
+			"10.0.2.0/24", true,
+		},
+		{"fills gaps",
+			[]string{"10.0.0.0/16"}, []string{"10.0.0.0/24", "10.0.2.0/24"}, 24,
+			"10.0.1.0/24", true,
+		},
+		{"skips partially used blocks",
+			[]string{"10.0.0.0/16"}, []string{"10.0.0.128/25"}, 24,
+			"10.0.1.0/24", true,
+		},
+		{"smaller block next to larger subnet",
+			[]string{"10.0.0.0/16"}, []string{"10.0.0.0/20"}, 28,
+			"10.0.16.0/28", true,
+		},
+		{"secondary CIDR block in address order",
+			[]string{"10.1.0.0/24", "10.0.0.0/24"}, []string{"10.0.0.0/24"}, 24,
+			"10.1.0.0/24", true,
+		},
+		{"netmask larger than VPC",
+			[]string{"10.0.0.0/24"}, nil, 16,
+			"", false,
+		},
+		{"VPC full",
+			[]string{"10.0.0.0/24"}, []string{"10.0.0.0/25", "10.0.0.128/25"}, 28,
+			"", false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			cidrBlock, found := firstFreeCIDRBlock(tc.vpcCIDRBlocks, tc.subnets, tc.netmaskLength)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, cidrBlock)
+		})
+	}
+}
+
+func TestNeedsCIDRBlockFromVPC(t *testing.T) {
+	netmaskLength := int64(24)
+	tt := []struct {
+		id       string
+		spec     svcapitypes.SubnetSpec
+		expected bool
+	}{
+		{"CIDR block", svcapitypes.SubnetSpec{CIDRBlock: aws.String("10.0.0.0/24")}, false},
+		{"netmask length",
+			svcapitypes.SubnetSpec{IPv4NetmaskLength: &netmaskLength}, true},
+		{"netmask length from IPAM pool",
+			svcapitypes.SubnetSpec{IPv4IPAMPoolID: aws.String("ipam-pool-1"), IPv4NetmaskLength: &netmaskLength}, false},
+		{"pinned CIDR block",
+			svcapitypes.SubnetSpec{CIDRBlock: aws.String("10.0.0.0/24"), IPv4NetmaskLength: &netmaskLength}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.Subnet{Spec: tc.spec}}
+			assert.Equal(t, tc.expected, needsCIDRBlockFromVPC(r))
+		})
+	}
+}
+
+func ipv6Association(cidrBlock, state string) *svcapitypes.SubnetIPv6CIDRBlockAssociation {
+	return &svcapitypes.SubnetIPv6CIDRBlockAssociation{
+		AssociationID: aws.String("subnet-cidr-assoc-" + state),
+		IPv6CIDRBlock: aws.String(cidrBlock),
+		IPv6CIDRBlockState: &svcapitypes.SubnetCIDRBlockState{
+			State: aws.String(state),
+		},
+	}
+}
+
+func TestSetIPv6CIDRBlock(t *testing.T) {
+	tt := []struct {
+		id       string
+		desired  svcapitypes.SubnetSpec
+		assocs   []*svcapitypes.SubnetIPv6CIDRBlockAssociation
+		expected *string
+		syncing  bool
+	}{
+		{"IPv4 only",
+			svcapitypes.SubnetSpec{}, nil,
+			nil, false,
+		},
+		{"add IPv6 CIDR block",
+			svcapitypes.SubnetSpec{IPv6CIDRBlock: aws.String("2600:1f14::/64")}, nil,
+			nil, false,
+		},
+		{"associated",
+			svcapitypes.SubnetSpec{IPv6CIDRBlock: aws.String("2600:1f14::/64")},
+			[]*svcapitypes.SubnetIPv6CIDRBlockAssociation{ipv6Association("2600:1f14::/64", "associated")},
+			aws.String("2600:1f14::/64"), false,
+		},
+		{"associated with different notation",
+			svcapitypes.SubnetSpec{IPv6CIDRBlock: aws.String("2600:1f14:0000::/64")},
+			[]*svcapitypes.SubnetIPv6CIDRBlockAssociation{ipv6Association("2600:1f14::/64", "associated")},
+			aws.String("2600:1f14:0000::/64"), false,
+		},
+		{"unset IPv6 CIDR block is unmanaged",
+			svcapitypes.SubnetSpec{},
+			[]*svcapitypes.SubnetIPv6CIDRBlockAssociation{ipv6Association("2600:1f14::/64", "associated")},
+			nil, false,
+		},
+		{"replacing IPv6 CIDR block",
+			svcapitypes.SubnetSpec{IPv6CIDRBlock: aws.String("2600:1f14:0:1::/64")},
+			[]*svcapitypes.SubnetIPv6CIDRBlockAssociation{
+				ipv6Association("2600:1f14::/64", "disassociating"),
+				ipv6Association("2600:1f14:0:1::/64", "associating"),
+			},
+			aws.String("2600:1f14:0:1::/64"), true,
+		},
+		{"allocated from IPv6 IPAM pool",
+			svcapitypes.SubnetSpec{IPv6IPAMPoolID: aws.String("ipam-pool-1")},
+			[]*svcapitypes.SubnetIPv6CIDRBlockAssociation{ipv6Association("2600:1f14::/64", "associated")},
+			nil, false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			desired := &resource{ko: &svcapitypes.Subnet{Spec: tc.desired}}
+			ko := desired.ko.DeepCopy()
+			ko.Status.IPv6CIDRBlockAssociationSet = tc.assocs
+
+			setIPv6CIDRBlock(desired, ko)
+			assert.Equal(t, tc.expected, ko.Spec.IPv6CIDRBlock)
+			assert.Equal(t, tc.syncing, isIPv6CIDRBlockSyncing(&resource{ko}))
+		})
+	}
+}
@@ -38,6 +38,14 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.IPv4IPAMPoolRef != nil {
+		ko.Spec.IPv4IPAMPoolID = nil
+	}
+
+	if ko.Spec.IPv6IPAMPoolRef != nil {
+		ko.Spec.IPv6IPAMPoolID = nil
+	}
+
 	if len(ko.Spec.RouteTableRefs) > 0 {
 		ko.Spec.RouteTables = nil
 	}
@@ -65,6 +73,18 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForIPv4IPAMPoolID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForIPv6IPAMPoolID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForRouteTables(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -84,6 +104,14 @@ func (rm *resourceManager) ResolveReferences(
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.Subnet) error {
 
+	if ko.Spec.IPv4IPAMPoolRef != nil && ko.Spec.IPv4IPAMPoolID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("IPv4IPAMPoolID", "IPv4IPAMPoolRef")
+	}
+
+	if ko.Spec.IPv6IPAMPoolRef != nil && ko.Spec.IPv6IPAMPoolID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("IPv6IPAMPoolID", "IPv6IPAMPoolRef")
+	}
+
 	if len(ko.Spec.RouteTableRefs) > 0 && len(ko.Spec.RouteTables) > 0 {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("RouteTables", "RouteTableRefs")
 	}
@@ -97,6 +125,134 @@ func validateReferenceFields(ko *svcapitypes.Subnet) error {
 	return nil
 }
 
+// resolveReferenceForIPv4IPAMPoolID reads the resource referenced
+// from IPv4IPAMPoolRef field and sets the IPv4IPAMPoolID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForIPv4IPAMPoolID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Subnet,
+) (hasReferences bool, err error) {
+	if ko.Spec.IPv4IPAMPoolRef != nil && ko.Spec.IPv4IPAMPoolRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.IPv4IPAMPoolRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: IPv4IPAMPoolRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.IPAMPool{}
+		if err := getReferencedResourceState_IPAMPool(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.IPv4IPAMPoolID = (*string)(obj.Status.IPAMPoolID)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_IPAMPool looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_IPAMPool(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.IPAMPool,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"IPAMPool",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"IPAMPool",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"IPAMPool",
+			namespace, name)
+	}
+	if obj.Status.IPAMPoolID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"IPAMPool",
+			namespace, name,
+			"Status.IPAMPoolID")
+	}
+	return nil
+}
+
+// resolveReferenceForIPv6IPAMPoolID reads the resource referenced
+// from IPv6IPAMPoolRef field and sets the IPv6IPAMPoolID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForIPv6IPAMPoolID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Subnet,
+) (hasReferences bool, err error) {
+	if ko.Spec.IPv6IPAMPoolRef != nil && ko.Spec.IPv6IPAMPoolRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.IPv6IPAMPoolRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: IPv6IPAMPoolRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.IPAMPool{}
+		if err := getReferencedResourceState_IPAMPool(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.IPv6IPAMPoolID = (*string)(obj.Status.IPAMPoolID)
+	}
+
+	return hasReferences, nil
+}
+
 // resolveReferenceForRouteTables reads the resource referenced
 // from RouteTableRefs field and sets the RouteTables
 // from referenced resource. Returns a boolean indicating whether a reference
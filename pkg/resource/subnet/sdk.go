@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"time"
@@ -272,6 +273,14 @@ func (rm *resourceManager) sdkFind(
 			ko.Spec.RouteTables[i] = assoc.RouteTableId
 		}
 	}
+
+	setAllocatedCIDRBlock(r, ko)
+	setIPv6CIDRBlock(r, ko)
+	if isIPv6CIDRBlockSyncing(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
 	return &resource{ko}, nil
 }
 
@@ -319,6 +328,17 @@ func (rm *resourceManager) sdkCreate(
 	}
 	updateTagSpecificationsInCreateRequest(desired, input)
 
+	// Without a CIDR block or IPAM pool, a free block of the requested
+	// netmask length is picked from the VPC. It is pinned in Spec.CIDRBlock
+	// once the subnet is created.
+	if needsCIDRBlockFromVPC(desired) {
+		unlock := lockVPCCIDRBlocks(*desired.ko.Spec.VPCID)
+		defer unlock()
+		if err = rm.setCIDRBlockFromVPC(ctx, desired, input); err != nil {
+			return nil, err
+		}
+	}
+
 	var resp *svcsdk.CreateSubnetOutput
 	_ = resp
 	resp, err = rm.sdkapi.CreateSubnet(ctx, input)
@@ -498,6 +518,7 @@ func (rm *resourceManager) sdkCreate(
 	if desired.ko.Spec.MapPublicIPOnLaunch != nil {
 		ko.Spec.MapPublicIPOnLaunch = desired.ko.Spec.MapPublicIPOnLaunch
 	}
+	setAllocatedCIDRBlock(desired, ko)
 
 	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("subnet created, requeue for updates"), nil)
 	err = ackrequeue.NeededAfter(fmt.Errorf("Reconciling to sync additional fields"), time.Second)
@@ -523,12 +544,34 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.CIDRBlock != nil {
 		res.CidrBlock = r.ko.Spec.CIDRBlock
 	}
+	if r.ko.Spec.IPv4IPAMPoolID != nil {
+		res.Ipv4IpamPoolId = r.ko.Spec.IPv4IPAMPoolID
+	}
+	if r.ko.Spec.IPv4NetmaskLength != nil {
+		ipv4NetmaskLengthCopy0 := *r.ko.Spec.IPv4NetmaskLength
+		if ipv4NetmaskLengthCopy0 > math.MaxInt32 || ipv4NetmaskLengthCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field Ipv4NetmaskLength is of type int32")
+		}
+		ipv4NetmaskLengthCopy := int32(ipv4NetmaskLengthCopy0)
+		res.Ipv4NetmaskLength = &ipv4NetmaskLengthCopy
+	}
 	if r.ko.Spec.IPv6CIDRBlock != nil {
 		res.Ipv6CidrBlock = r.ko.Spec.IPv6CIDRBlock
 	}
+	if r.ko.Spec.IPv6IPAMPoolID != nil {
+		res.Ipv6IpamPoolId = r.ko.Spec.IPv6IPAMPoolID
+	}
 	if r.ko.Spec.IPv6Native != nil {
 		res.Ipv6Native = r.ko.Spec.IPv6Native
 	}
+	if r.ko.Spec.IPv6NetmaskLength != nil {
+		ipv6NetmaskLengthCopy0 := *r.ko.Spec.IPv6NetmaskLength
+		if ipv6NetmaskLengthCopy0 > math.MaxInt32 || ipv6NetmaskLengthCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field Ipv6NetmaskLength is of type int32")
+		}
+		ipv6NetmaskLengthCopy := int32(ipv6NetmaskLengthCopy0)
+		res.Ipv6NetmaskLength = &ipv6NetmaskLengthCopy
+	}
 	if r.ko.Spec.OutpostARN != nil {
 		res.OutpostArn = r.ko.Spec.OutpostARN
 	}
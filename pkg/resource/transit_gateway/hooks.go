@@ -15,12 +15,16 @@ package transit_gateway
 
 import (
 	"context"
+	"fmt"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
 )
 
@@ -32,12 +36,15 @@ func isResourceDeleted(r *resource) bool {
 	return status == string(svcsdktypes.TransitGatewayStateDeleted)
 }
 
+// isResourcePending returns true while the transit gateway is being created
+// or while a ModifyTransitGateway call is still being applied.
 func isResourcePending(r *resource) bool {
 	if r.ko.Status.State == nil {
 		return false
 	}
 	status := *r.ko.Status.State
-	return status == string(svcsdktypes.TransitGatewayStatePending)
+	return status == string(svcsdktypes.TransitGatewayStatePending) ||
+		status == string(svcsdktypes.TransitGatewayStateModifying)
 }
 
 func (rm *resourceManager) customUpdateTransitGateway(
@@ -57,6 +64,12 @@ func (rm *resourceManager) customUpdateTransitGateway(
 	// (now updated.Spec) reflects the latest resource state.
 	updated = rm.concreteResource(desired.DeepCopy())
 
+	if delta.DifferentAt("Spec.Options") {
+		if err := validateOptionsModification(ctx, rm.sdkapi, rm.metrics, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		if err := tags.Sync(
 			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.TransitGatewayID,
@@ -66,9 +79,257 @@ func (rm *resourceManager) customUpdateTransitGateway(
 		}
 	}
 
+	if delta.DifferentAt("Spec.Description") || delta.DifferentAt("Spec.Options") {
+		if err := rm.modifyTransitGateway(ctx, desired, latest, delta); err != nil {
+			return nil, err
+		}
+	}
+
 	return updated, nil
 }
 
+// modifyTransitGateway calls ModifyTransitGateway with the description and
+// the options that differ between the desired and latest state. Options that
+// are not set in the desired Spec are left untouched.
+func (rm *resourceManager) modifyTransitGateway(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyTransitGateway")
+	defer func(err error) { exit(err) }(err)
+
+	input := &svcsdk.ModifyTransitGatewayInput{
+		TransitGatewayId: latest.ko.Status.TransitGatewayID,
+	}
+	if delta.DifferentAt("Spec.Description") && desired.ko.Spec.Description != nil {
+		input.Description = desired.ko.Spec.Description
+	}
+	if delta.DifferentAt("Spec.Options") && desired.ko.Spec.Options != nil {
+		// Options that cannot be modified were rejected by
+		// validateOptionsModification.
+		input.Options = newModifyTransitGatewayOptions(desired.ko.Spec.Options, latest.ko.Spec.Options)
+	}
+	if input.Description == nil && input.Options == nil {
+		return nil
+	}
+
+	_, err = rm.sdkapi.ModifyTransitGateway(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyTransitGateway", err)
+	return err
+}
+
+// activeAttachmentStates are the transit gateway attachment states in which
+// an attachment keeps the AmazonSideAsn of the transit gateway from being
+// modified.
+var activeAttachmentStates = []string{
+	string(svcsdktypes.TransitGatewayAttachmentStateAvailable),
+	string(svcsdktypes.TransitGatewayAttachmentStateInitiating),
+	string(svcsdktypes.TransitGatewayAttachmentStateInitiatingRequest),
+	string(svcsdktypes.TransitGatewayAttachmentStateModifying),
+	string(svcsdktypes.TransitGatewayAttachmentStatePending),
+	string(svcsdktypes.TransitGatewayAttachmentStatePendingAcceptance),
+}
+
+// asnAttachmentTypes are the transit gateway attachment types with which
+// ModifyTransitGateway rejects a change of the AmazonSideAsn.
+var asnAttachmentTypes = []string{
+	string(svcsdktypes.TransitGatewayAttachmentResourceTypeConnect),
+	string(svcsdktypes.TransitGatewayAttachmentResourceTypeDirectConnectGateway),
+	string(svcsdktypes.TransitGatewayAttachmentResourceTypeVpn),
+}
+
+type attachmentsClient interface {
+	DescribeTransitGatewayAttachments(context.Context, *svcsdk.DescribeTransitGatewayAttachmentsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeTransitGatewayAttachmentsOutput, error)
+}
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+// validateOptionsModification returns a terminal error when the desired
+// options change a transit gateway option that cannot be modified:
+// MulticastSupport can only be set when the transit gateway is created, and
+// AmazonSideAsn cannot be modified while the transit gateway has VPN, Direct
+// Connect gateway or Connect attachments.
+func validateOptionsModification(
+	ctx context.Context,
+	client attachmentsClient,
+	mr metricsRecorder,
+	desired *resource,
+	latest *resource,
+) error {
+	desiredOpts := desired.ko.Spec.Options
+	latestOpts := latest.ko.Spec.Options
+	if desiredOpts == nil || latestOpts == nil {
+		return nil
+	}
+
+	if desiredOpts.MulticastSupport != nil && latestOpts.MulticastSupport != nil &&
+		*desiredOpts.MulticastSupport != *latestOpts.MulticastSupport {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"Options.MulticastSupport cannot be modified after the transit gateway is created, "+
+				"revert it to %q or recreate the transit gateway",
+			*latestOpts.MulticastSupport,
+		))
+	}
+
+	if desiredOpts.AmazonSideASN != nil && latestOpts.AmazonSideASN != nil &&
+		*desiredOpts.AmazonSideASN != *latestOpts.AmazonSideASN {
+		resp, err := client.DescribeTransitGatewayAttachments(ctx, &svcsdk.DescribeTransitGatewayAttachmentsInput{
+			Filters: []svcsdktypes.Filter{
+				{
+					Name:   aws.String("transit-gateway-id"),
+					Values: []string{aws.ToString(latest.ko.Status.TransitGatewayID)},
+				},
+				{
+					Name:   aws.String("resource-type"),
+					Values: asnAttachmentTypes,
+				},
+				{
+					Name:   aws.String("state"),
+					Values: activeAttachmentStates,
+				},
+			},
+			MaxResults: aws.Int32(5),
+		})
+		mr.RecordAPICall("READ_MANY", "DescribeTransitGatewayAttachments", err)
+		if err != nil {
+			return err
+		}
+		if len(resp.TransitGatewayAttachments) > 0 {
+			attachment := resp.TransitGatewayAttachments[0]
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"Options.AmazonSideASN cannot be modified while the transit gateway has %s attachment %s, "+
+					"revert it to %d or delete the VPN, Direct Connect gateway and Connect attachments first",
+				attachment.ResourceType, aws.ToString(attachment.TransitGatewayAttachmentId),
+				*latestOpts.AmazonSideASN,
+			))
+		}
+	}
+	return nil
+}
+
+// newModifyTransitGatewayOptions returns the ModifyTransitGatewayOptions for
+// the options set in desired which differ from latest, including the
+// transit gateway CIDR blocks to add and remove. It returns nil if there is
+// nothing to modify.
+func newModifyTransitGatewayOptions(
+	desired *svcapitypes.TransitGatewayRequestOptions,
+	latest *svcapitypes.TransitGatewayRequestOptions,
+) *svcsdktypes.ModifyTransitGatewayOptions {
+	if latest == nil {
+		latest = &svcapitypes.TransitGatewayRequestOptions{}
+	}
+	res := &svcsdktypes.ModifyTransitGatewayOptions{}
+	modified := false
+
+	if desired.AmazonSideASN != nil && *desired.AmazonSideASN != aws.ToInt64(latest.AmazonSideASN) {
+		res.AmazonSideAsn = desired.AmazonSideASN
+		modified = true
+	}
+	if desired.AutoAcceptSharedAttachments != nil && *desired.AutoAcceptSharedAttachments != aws.ToString(latest.AutoAcceptSharedAttachments) {
+		res.AutoAcceptSharedAttachments = svcsdktypes.AutoAcceptSharedAttachmentsValue(*desired.AutoAcceptSharedAttachments)
+		modified = true
+	}
+	if desired.DefaultRouteTableAssociation != nil && *desired.DefaultRouteTableAssociation != aws.ToString(latest.DefaultRouteTableAssociation) {
+		res.DefaultRouteTableAssociation = svcsdktypes.DefaultRouteTableAssociationValue(*desired.DefaultRouteTableAssociation)
+		modified = true
+	}
+	if desired.DefaultRouteTablePropagation != nil && *desired.DefaultRouteTablePropagation != aws.ToString(latest.DefaultRouteTablePropagation) {
+		res.DefaultRouteTablePropagation = svcsdktypes.DefaultRouteTablePropagationValue(*desired.DefaultRouteTablePropagation)
+		modified = true
+	}
+	if desired.DNSSupport != nil && *desired.DNSSupport != aws.ToString(latest.DNSSupport) {
+		res.DnsSupport = svcsdktypes.DnsSupportValue(*desired.DNSSupport)
+		modified = true
+	}
+	if desired.VPNECMPSupport != nil && *desired.VPNECMPSupport != aws.ToString(latest.VPNECMPSupport) {
+		res.VpnEcmpSupport = svcsdktypes.VpnEcmpSupportValue(*desired.VPNECMPSupport)
+		modified = true
+	}
+	// The CIDR blocks are left untouched unless they are set in desired.
+	if desired.TransitGatewayCIDRBlocks != nil {
+		res.AddTransitGatewayCidrBlocks, res.RemoveTransitGatewayCidrBlocks = getCIDRBlocksDifference(
+			desired.TransitGatewayCIDRBlocks,
+			latest.TransitGatewayCIDRBlocks,
+		)
+		if len(res.AddTransitGatewayCidrBlocks) > 0 || len(res.RemoveTransitGatewayCidrBlocks) > 0 {
+			modified = true
+		}
+	}
+
+	if !modified {
+		return nil
+	}
+	return res
+}
+
+// customPreCompare compares the transit gateway CIDR blocks as sets, because
+// DescribeTransitGateways does not preserve their order.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.Options == nil || b.ko.Spec.Options == nil ||
+		a.ko.Spec.Options.TransitGatewayCIDRBlocks == nil {
+		return
+	}
+	toAdd, toRemove := getCIDRBlocksDifference(
+		a.ko.Spec.Options.TransitGatewayCIDRBlocks,
+		b.ko.Spec.Options.TransitGatewayCIDRBlocks,
+	)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add(
+			"Spec.Options.TransitGatewayCIDRBlocks",
+			a.ko.Spec.Options.TransitGatewayCIDRBlocks,
+			b.ko.Spec.Options.TransitGatewayCIDRBlocks,
+		)
+	}
+}
+
+// getCIDRBlocksDifference returns the CIDR blocks that are in desired but not
+// in latest (toAdd), and those in latest but not in desired (toRemove).
+func getCIDRBlocksDifference(
+	desired []*string,
+	latest []*string,
+) (toAdd []string, toRemove []string) {
+	desiredSet := map[string]struct{}{}
+	for _, cidr := range desired {
+		if cidr != nil {
+			desiredSet[*cidr] = struct{}{}
+		}
+	}
+	latestSet := map[string]struct{}{}
+	for _, cidr := range latest {
+		if cidr != nil {
+			latestSet[*cidr] = struct{}{}
+		}
+	}
+	for _, cidr := range desired {
+		if cidr == nil {
+			continue
+		}
+		if _, ok := latestSet[*cidr]; !ok {
+			toAdd = append(toAdd, *cidr)
+			latestSet[*cidr] = struct{}{}
+		}
+	}
+	for _, cidr := range latest {
+		if cidr == nil {
+			continue
+		}
+		if _, ok := desiredSet[*cidr]; !ok {
+			toRemove = append(toRemove, *cidr)
+			desiredSet[*cidr] = struct{}{}
+		}
+	}
+	return toAdd, toRemove
+}
+
 // updateTagSpecificationsInCreateRequest adds
 // Tags defined in the Spec to CreateTransitGatewayInput.TagSpecification
 // and ensures the ResourceType is always set to 'transit-gateway'
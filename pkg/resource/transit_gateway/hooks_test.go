@@ -0,0 +1,178 @@
+package transit_gateway
+
+import (
+	"context"
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPreCompare(t *testing.T) {
+	createTestResource := func(cidrBlocks []string) *resource {
+		return &resource{
+			ko: &svcapitypes.TransitGateway{
+				Spec: svcapitypes.TransitGatewaySpec{
+					Options: &svcapitypes.TransitGatewayRequestOptions{
+						TransitGatewayCIDRBlocks: aws.StringSlice(cidrBlocks),
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		id       string
+		desired  []string
+		latest   []string
+		toAdd    []string
+		toRemove []string
+	}{
+		{"identical", []string{"10.0.0.0/24"}, []string{"10.0.0.0/24"}, nil, nil},
+		{"different order",
+			[]string{"10.0.0.0/24", "10.0.1.0/24"}, []string{"10.0.1.0/24", "10.0.0.0/24"},
+			nil, nil,
+		},
+		{"add block", []string{"10.0.0.0/24", "10.0.1.0/24"}, []string{"10.0.0.0/24"}, []string{"10.0.1.0/24"}, nil},
+		{"remove block", nil, []string{"10.0.0.0/24"}, nil, []string{"10.0.0.0/24"}},
+		{"replace block", []string{"10.0.1.0/24"}, []string{"10.0.0.0/24"}, []string{"10.0.1.0/24"}, []string{"10.0.0.0/24"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := createTestResource(tc.desired)
+			b := createTestResource(tc.latest)
+
+			toAdd, toRemove := getCIDRBlocksDifference(a.ko.Spec.Options.TransitGatewayCIDRBlocks, b.ko.Spec.Options.TransitGatewayCIDRBlocks)
+			assert.Equal(t, tc.toAdd, toAdd)
+			assert.Equal(t, tc.toRemove, toRemove)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, len(toAdd) > 0 || len(toRemove) > 0, delta.DifferentAt("Spec.Options.TransitGatewayCIDRBlocks"))
+		})
+	}
+}
+
+func TestNewModifyTransitGatewayOptions(t *testing.T) {
+	latest := &svcapitypes.TransitGatewayRequestOptions{
+		AmazonSideASN:                aws.Int64(64512),
+		AutoAcceptSharedAttachments:  aws.String("disable"),
+		DefaultRouteTableAssociation: aws.String("enable"),
+		DefaultRouteTablePropagation: aws.String("enable"),
+		DNSSupport:                   aws.String("enable"),
+		MulticastSupport:             aws.String("disable"),
+		TransitGatewayCIDRBlocks:     aws.StringSlice([]string{"10.0.0.0/24"}),
+		VPNECMPSupport:               aws.String("enable"),
+	}
+
+	t.Run("unset options are not modified", func(t *testing.T) {
+		desired := &svcapitypes.TransitGatewayRequestOptions{
+			TransitGatewayCIDRBlocks: aws.StringSlice([]string{"10.0.0.0/24"}),
+		}
+		assert.Nil(t, newModifyTransitGatewayOptions(desired, latest))
+	})
+
+	t.Run("changed options are modified", func(t *testing.T) {
+		desired := &svcapitypes.TransitGatewayRequestOptions{
+			AmazonSideASN:            aws.Int64(64512),
+			DNSSupport:               aws.String("disable"),
+			VPNECMPSupport:           aws.String("disable"),
+			TransitGatewayCIDRBlocks: aws.StringSlice([]string{"10.0.1.0/24"}),
+		}
+		opts := newModifyTransitGatewayOptions(desired, latest)
+		assert.NotNil(t, opts)
+		assert.Nil(t, opts.AmazonSideAsn)
+		assert.Equal(t, "disable", string(opts.DnsSupport))
+		assert.Equal(t, "disable", string(opts.VpnEcmpSupport))
+		assert.Equal(t, "", string(opts.AutoAcceptSharedAttachments))
+		assert.Equal(t, []string{"10.0.1.0/24"}, opts.AddTransitGatewayCidrBlocks)
+		assert.Equal(t, []string{"10.0.0.0/24"}, opts.RemoveTransitGatewayCidrBlocks)
+	})
+
+	t.Run("unset CIDR blocks are not removed", func(t *testing.T) {
+		desired := &svcapitypes.TransitGatewayRequestOptions{
+			DNSSupport: aws.String("disable"),
+		}
+		opts := newModifyTransitGatewayOptions(desired, latest)
+		assert.NotNil(t, opts)
+		assert.Equal(t, "disable", string(opts.DnsSupport))
+		assert.Empty(t, opts.RemoveTransitGatewayCidrBlocks)
+	})
+
+}
+
+type fakeAttachmentsClient struct {
+	attachments []svcsdktypes.TransitGatewayAttachment
+	calls       int
+}
+
+func (c *fakeAttachmentsClient) DescribeTransitGatewayAttachments(
+	_ context.Context,
+	_ *svcsdk.DescribeTransitGatewayAttachmentsInput,
+	_ ...func(*svcsdk.Options),
+) (*svcsdk.DescribeTransitGatewayAttachmentsOutput, error) {
+	c.calls++
+	return &svcsdk.DescribeTransitGatewayAttachmentsOutput{TransitGatewayAttachments: c.attachments}, nil
+}
+
+type fakeMetricsRecorder struct{}
+
+func (fakeMetricsRecorder) RecordAPICall(string, string, error) {}
+
+func TestValidateOptionsModification(t *testing.T) {
+	createTestResource := func(opts *svcapitypes.TransitGatewayRequestOptions) *resource {
+		return &resource{ko: &svcapitypes.TransitGateway{
+			Spec:   svcapitypes.TransitGatewaySpec{Options: opts},
+			Status: svcapitypes.TransitGatewayStatus{TransitGatewayID: aws.String("tgw-1")},
+		}}
+	}
+	latest := createTestResource(&svcapitypes.TransitGatewayRequestOptions{
+		AmazonSideASN:    aws.Int64(64512),
+		MulticastSupport: aws.String("disable"),
+	})
+	vpnAttachment := svcsdktypes.TransitGatewayAttachment{
+		ResourceType:               svcsdktypes.TransitGatewayAttachmentResourceTypeVpn,
+		TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+	}
+
+	tt := []struct {
+		id          string
+		desired     *svcapitypes.TransitGatewayRequestOptions
+		attachments []svcsdktypes.TransitGatewayAttachment
+		terminal    bool
+		calls       int
+	}{
+		{"unset options", nil, nil, false, 0},
+		{"unchanged options",
+			&svcapitypes.TransitGatewayRequestOptions{AmazonSideASN: aws.Int64(64512), MulticastSupport: aws.String("disable")},
+			nil, false, 0,
+		},
+		{"modifiable option", &svcapitypes.TransitGatewayRequestOptions{DNSSupport: aws.String("disable")}, nil, false, 0},
+		{"multicast support changed", &svcapitypes.TransitGatewayRequestOptions{MulticastSupport: aws.String("enable")}, nil, true, 0},
+		{"amazon side ASN changed without attachments", &svcapitypes.TransitGatewayRequestOptions{AmazonSideASN: aws.Int64(64513)}, nil, false, 1},
+		{"amazon side ASN changed with a VPN attachment",
+			&svcapitypes.TransitGatewayRequestOptions{AmazonSideASN: aws.Int64(64513)},
+			[]svcsdktypes.TransitGatewayAttachment{vpnAttachment}, true, 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			client := &fakeAttachmentsClient{attachments: tc.attachments}
+			err := validateOptionsModification(context.TODO(), client, fakeMetricsRecorder{}, createTestResource(tc.desired), latest)
+			if tc.terminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tc.calls, client.calls)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package transit_gateway_peering_attachment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrTransitGatewayPeeringAttachmentInitiating = fmt.Errorf(
+		"TransitGatewayPeeringAttachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.TransitGatewayAttachmentStateInitiatingRequest,
+	)
+	ErrTransitGatewayPeeringAttachmentPending = fmt.Errorf(
+		"TransitGatewayPeeringAttachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.TransitGatewayAttachmentStatePending,
+	)
+	ErrTransitGatewayPeeringAttachmentPendingAcceptance = fmt.Errorf(
+		"TransitGatewayPeeringAttachment in '%v' state, waiting for the peer transit gateway owner to accept it",
+		svcsdktypes.TransitGatewayAttachmentStatePendingAcceptance,
+	)
+	ErrTransitGatewayPeeringAttachmentDeleting = fmt.Errorf(
+		"TransitGatewayPeeringAttachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.TransitGatewayAttachmentStateDeleting,
+	)
+)
+
+var (
+	requeueWaitWhileInitiating = ackrequeue.NeededAfter(
+		ErrTransitGatewayPeeringAttachmentInitiating,
+		5*time.Second,
+	)
+	requeueWaitWhilePending = ackrequeue.NeededAfter(
+		ErrTransitGatewayPeeringAttachmentPending,
+		5*time.Second,
+	)
+	requeueWaitWhilePendingAcceptance = ackrequeue.NeededAfter(
+		ErrTransitGatewayPeeringAttachmentPendingAcceptance,
+		30*time.Second,
+	)
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		ErrTransitGatewayPeeringAttachmentDeleting,
+		5*time.Second,
+	)
+)
+
+func isTransitGatewayPeeringAttachmentInitiating(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_initiating) ||
+		state == string(svcapitypes.TransitGatewayAttachmentState_initiatingRequest)
+}
+
+func isTransitGatewayPeeringAttachmentPendingAcceptance(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_pendingAcceptance)
+}
+
+func isTransitGatewayPeeringAttachmentPending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_pending) ||
+		state == string(svcapitypes.TransitGatewayAttachmentState_modifying)
+}
+
+func isTransitGatewayPeeringAttachmentAvailable(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_available)
+}
+
+func isTransitGatewayPeeringAttachmentDeleting(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_deleting)
+}
+
+func isTransitGatewayPeeringAttachmentDeleted(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_deleted)
+}
+
+// isTransitGatewayPeeringAttachmentFailed returns true if the peering request
+// was rejected by the accepter or failed, after which the attachment can no
+// longer become available.
+func isTransitGatewayPeeringAttachmentFailed(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	state := *r.ko.Status.State
+	return state == string(svcapitypes.TransitGatewayAttachmentState_failed) ||
+		state == string(svcapitypes.TransitGatewayAttachmentState_failing) ||
+		state == string(svcapitypes.TransitGatewayAttachmentState_rejected) ||
+		state == string(svcapitypes.TransitGatewayAttachmentState_rejecting)
+}
+
+func (rm *resourceManager) customUpdateTransitGatewayPeeringAttachment(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateTransitGatewayPeeringAttachment")
+	defer func(err error) { exit(err) }(err)
+
+	if isTransitGatewayPeeringAttachmentInitiating(latest) {
+		return desired, requeueWaitWhileInitiating
+	}
+	if isTransitGatewayPeeringAttachmentPending(latest) {
+		return desired, requeueWaitWhilePending
+	}
+	if isTransitGatewayPeeringAttachmentDeleting(latest) {
+		return desired, requeueWaitWhileDeleting
+	}
+	if isTransitGatewayPeeringAttachmentFailed(latest) {
+		msg := fmt.Sprintf("TransitGatewayPeeringAttachment in '%s' state", *latest.ko.Status.State)
+		if latest.ko.Status.Status != nil && latest.ko.Status.Status.Message != nil {
+			msg = fmt.Sprintf("%s: %s", msg, *latest.ko.Status.Status.Message)
+		}
+		return nil, ackerr.NewTerminalError(fmt.Errorf("%s", msg))
+	}
+	// If the Transit Gateway Peering Attachment is Pending Acceptance or Available, continue
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.ID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.AcceptRequest") {
+		if desired.ko.Spec.AcceptRequest != nil && *desired.ko.Spec.AcceptRequest {
+			// Accept the Transit Gateway Peering Attachment, if the field is set to 'true' and is still at state Pending Acceptance
+			if isTransitGatewayPeeringAttachmentPendingAcceptance(latest) {
+				if err := rm.acceptPeeringAttachment(ctx, latest); err != nil {
+					return nil, err
+				}
+				updated.ko.Status.RequestAccepted = aws.Bool(true)
+				// This causes a requeue and the rest of the fields will be synced on the next reconciliation loop
+				ackcondition.SetSynced(updated, corev1.ConditionFalse, nil, nil)
+				return updated, nil
+			}
+		} else if isTransitGatewayPeeringAttachmentPendingAcceptance(latest) {
+			// Nothing to do on this side until the owner of the peer transit
+			// gateway accepts the request.
+			return updated, requeueWaitWhilePendingAcceptance
+		} else if desired.ko.Spec.AcceptRequest != nil && aws.ToBool(latest.ko.Status.RequestAccepted) {
+			// Throw a Terminal Error, if the field was set to 'true' and is now set to 'false'
+			msg := "you cannot set AcceptRequest to false after setting it to true"
+			return nil, ackerr.NewTerminalError(fmt.Errorf("%s", msg))
+		}
+	}
+
+	return updated, nil
+}
+
+// acceptPeeringAttachment accepts the peering attachment on the accepter side,
+// which lives in the peer Region.
+func (rm *resourceManager) acceptPeeringAttachment(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.acceptPeeringAttachment")
+	defer func(err error) { exit(err) }(err)
+
+	client, err := rm.accepterClient(r)
+	if err != nil {
+		return err
+	}
+
+	input := &svcsdk.AcceptTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: r.ko.Status.ID,
+	}
+	resp, err := client.AcceptTransitGatewayPeeringAttachment(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AcceptTransitGatewayPeeringAttachment", err)
+	if err != nil {
+		return err
+	}
+	rlog.Debug("Transit Gateway Peering Attachment accepted", "apiResponse", resp)
+	return nil
+}
+
+// accepterClient returns the EC2 client used to accept the peering
+// attachment. If this resource manager already targets the accepter Region and
+// account (e.g. the attachment was adopted on the accepter side), its own
+// client is used. Otherwise a client for Spec.PeerRegion is built from the
+// controller's configuration, which is only possible when the peer transit
+// gateway is owned by the same account.
+func (rm *resourceManager) accepterClient(
+	r *resource,
+) (*svcsdk.Client, error) {
+	accepterRegion := aws.ToString(r.ko.Spec.PeerRegion)
+	accepterAccountID := aws.ToString(r.ko.Spec.PeerAccountID)
+	if r.ko.Status.AccepterTgwInfo != nil {
+		accepterRegion = aws.ToString(r.ko.Status.AccepterTgwInfo.Region)
+		accepterAccountID = aws.ToString(r.ko.Status.AccepterTgwInfo.OwnerID)
+	}
+
+	if accepterAccountID != string(rm.awsAccountID) {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"cannot accept a peering attachment owned by account %s, "+
+				"adopt the attachment in that account and set AcceptRequest there instead",
+			accepterAccountID,
+		))
+	}
+	if accepterRegion == string(rm.awsRegion) {
+		return rm.sdkapi, nil
+	}
+	return svcsdk.NewFromConfig(rm.clientcfg, func(o *svcsdk.Options) {
+		o.Region = accepterRegion
+	}), nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateTransitGatewayPeeringAttachmentInput.TagSpecification
+// and ensures the ResourceType is always set to 'transit-gateway-attachment'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateTransitGatewayPeeringAttachmentInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = svcsdktypes.ResourceTypeTransitGatewayAttachment
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
+
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.ID == nil
+}
@@ -0,0 +1,175 @@
+package transit_gateway_peering_attachment
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+)
+
+func TestTransitGatewayPeeringAttachmentState(t *testing.T) {
+	tt := []struct {
+		state             *string
+		initiating        bool
+		pending           bool
+		pendingAcceptance bool
+		available         bool
+		deleting          bool
+		deleted           bool
+		failed            bool
+	}{
+		{state: nil},
+		{state: aws.String("initiating"), initiating: true},
+		{state: aws.String("initiatingRequest"), initiating: true},
+		{state: aws.String("pending"), pending: true},
+		{state: aws.String("modifying"), pending: true},
+		{state: aws.String("pendingAcceptance"), pendingAcceptance: true},
+		{state: aws.String("available"), available: true},
+		{state: aws.String("deleting"), deleting: true},
+		{state: aws.String("deleted"), deleted: true},
+		{state: aws.String("rejecting"), failed: true},
+		{state: aws.String("rejected"), failed: true},
+		{state: aws.String("failing"), failed: true},
+		{state: aws.String("failed"), failed: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(aws.StringValue(tc.state), func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.TransitGatewayPeeringAttachment{
+				Status: svcapitypes.TransitGatewayPeeringAttachmentStatus{State: tc.state},
+			}}
+			assert.Equal(t, tc.initiating, isTransitGatewayPeeringAttachmentInitiating(r))
+			assert.Equal(t, tc.pending, isTransitGatewayPeeringAttachmentPending(r))
+			assert.Equal(t, tc.pendingAcceptance, isTransitGatewayPeeringAttachmentPendingAcceptance(r))
+			assert.Equal(t, tc.available, isTransitGatewayPeeringAttachmentAvailable(r))
+			assert.Equal(t, tc.deleting, isTransitGatewayPeeringAttachmentDeleting(r))
+			assert.Equal(t, tc.deleted, isTransitGatewayPeeringAttachmentDeleted(r))
+			assert.Equal(t, tc.failed, isTransitGatewayPeeringAttachmentFailed(r))
+		})
+	}
+}
+
+func TestAccepterClient(t *testing.T) {
+	rm := &resourceManager{
+		clientcfg:    awsv2.Config{},
+		awsAccountID: ackv1alpha1.AWSAccountID("111111111111"),
+		awsRegion:    ackv1alpha1.AWSRegion("us-west-2"),
+		sdkapi:       svcsdk.New(svcsdk.Options{Region: "us-west-2"}),
+	}
+
+	tt := []struct {
+		id           string
+		spec         svcapitypes.TransitGatewayPeeringAttachmentSpec
+		accepterInfo *svcapitypes.PeeringTgwInfo
+		region       string
+		ownClient    bool
+		terminal     bool
+	}{
+		{"same region",
+			svcapitypes.TransitGatewayPeeringAttachmentSpec{
+				PeerAccountID: aws.String("111111111111"),
+				PeerRegion:    aws.String("us-west-2"),
+			},
+			nil, "us-west-2", true, false,
+		},
+		{"peer region",
+			svcapitypes.TransitGatewayPeeringAttachmentSpec{
+				PeerAccountID: aws.String("111111111111"),
+				PeerRegion:    aws.String("eu-west-1"),
+			},
+			nil, "eu-west-1", false, false,
+		},
+		{"accepter info takes precedence",
+			svcapitypes.TransitGatewayPeeringAttachmentSpec{
+				PeerAccountID: aws.String("111111111111"),
+				PeerRegion:    aws.String("eu-west-1"),
+			},
+			&svcapitypes.PeeringTgwInfo{
+				OwnerID: aws.String("111111111111"),
+				Region:  aws.String("us-west-2"),
+			},
+			"us-west-2", true, false,
+		},
+		{"peer account",
+			svcapitypes.TransitGatewayPeeringAttachmentSpec{
+				PeerAccountID: aws.String("222222222222"),
+				PeerRegion:    aws.String("eu-west-1"),
+			},
+			nil, "", false, true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.TransitGatewayPeeringAttachment{
+				Spec:   tc.spec,
+				Status: svcapitypes.TransitGatewayPeeringAttachmentStatus{AccepterTgwInfo: tc.accepterInfo},
+			}}
+			client, err := rm.accepterClient(r)
+			if tc.terminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.region, client.Options().Region)
+			assert.Equal(t, tc.ownClient, client == rm.sdkapi)
+		})
+	}
+}
+
+func TestCustomUpdateAcceptRequest(t *testing.T) {
+	createTestResource := func(acceptRequest *bool, requestAccepted *bool) *resource {
+		return &resource{ko: &svcapitypes.TransitGatewayPeeringAttachment{
+			Spec: svcapitypes.TransitGatewayPeeringAttachmentSpec{AcceptRequest: acceptRequest},
+			Status: svcapitypes.TransitGatewayPeeringAttachmentStatus{
+				ID:              aws.String("tgw-attach-1"),
+				RequestAccepted: requestAccepted,
+				State:           aws.String("available"),
+			},
+		}}
+	}
+
+	tt := []struct {
+		id              string
+		acceptRequest   *bool
+		requestAccepted *bool
+		terminal        bool
+	}{
+		{"accepted by the peer owner", aws.Bool(false), aws.Bool(false), false},
+		{"accepted by the controller", aws.Bool(true), aws.Bool(true), false},
+		{"changed from true to false", aws.Bool(false), aws.Bool(true), true},
+		{"unset after being accepted", nil, aws.Bool(true), false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			rm := &resourceManager{}
+			desired := createTestResource(tc.acceptRequest, tc.requestAccepted)
+			// The read hook reports an available attachment as accepted only
+			// when the controller accepted it.
+			latest := createTestResource(tc.acceptRequest, tc.requestAccepted)
+			if aws.BoolValue(tc.requestAccepted) && tc.acceptRequest != nil {
+				latest.ko.Spec.AcceptRequest = aws.Bool(true)
+			}
+
+			_, err := rm.customUpdateTransitGatewayPeeringAttachment(
+				context.TODO(), desired, latest, newResourceDelta(desired, latest),
+			)
+			if tc.terminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
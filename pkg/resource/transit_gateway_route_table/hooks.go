@@ -0,0 +1,796 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package transit_gateway_route_table
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrAssociationsDisassociating = fmt.Errorf(
+		"TransitGatewayRouteTable has associations or propagations still being removed, cannot be deleted",
+	)
+	requeueWaitWhileDisassociating = ackrequeue.NeededAfter(
+		ErrAssociationsDisassociating,
+		5*time.Second,
+	)
+)
+
+func isResourceDeleted(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return true
+	}
+	status := *r.ko.Status.State
+	return status == string(svcsdktypes.TransitGatewayRouteTableStateDeleted)
+}
+
+func isResourcePending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	status := *r.ko.Status.State
+	return status == string(svcsdktypes.TransitGatewayRouteTableStatePending)
+}
+
+func (rm *resourceManager) customUpdateTransitGatewayRouteTable(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateTransitGatewayRouteTable")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Associations") {
+		if err = rm.syncAssociations(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Propagations") {
+		if err = rm.syncPropagations(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Routes") {
+		if err = rm.syncRoutes(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.TransitGatewayRouteTableID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// customPreCompare compares Spec.Associations and Spec.Propagations as sets
+// of transit gateway attachment IDs, and Spec.Routes as a set of static routes
+// keyed by destination, because EC2 does not return them in the order they
+// were declared.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	toAdd, toRemove := getAttachmentIDsDifference(a.ko.Spec.Associations, b.ko.Spec.Associations)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.Associations", a.ko.Spec.Associations, b.ko.Spec.Associations)
+	}
+	toAdd, toRemove = getAttachmentIDsDifference(a.ko.Spec.Propagations, b.ko.Spec.Propagations)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add("Spec.Propagations", a.ko.Spec.Propagations, b.ko.Spec.Propagations)
+	}
+	toCreate, toReplace, toDelete := getRoutesDifference(a.ko.Spec.Routes, b.ko.Spec.Routes)
+	if len(toCreate) > 0 || len(toReplace) > 0 || len(toDelete) > 0 {
+		delta.Add("Spec.Routes", a.ko.Spec.Routes, b.ko.Spec.Routes)
+	}
+}
+
+// getAttachmentIDsDifference returns the attachment IDs that are desired but
+// not present in latest, and those present in latest but no longer desired.
+func getAttachmentIDsDifference(
+	desired []*string,
+	latest []*string,
+) (toAdd []string, toRemove []string) {
+	desiredSet := map[string]struct{}{}
+	for _, id := range desired {
+		if id != nil {
+			desiredSet[*id] = struct{}{}
+		}
+	}
+	latestSet := map[string]struct{}{}
+	for _, id := range latest {
+		if id != nil {
+			latestSet[*id] = struct{}{}
+		}
+	}
+	for _, id := range desired {
+		if id == nil {
+			continue
+		}
+		if _, ok := latestSet[*id]; !ok {
+			toAdd = append(toAdd, *id)
+			latestSet[*id] = struct{}{}
+		}
+	}
+	for _, id := range latest {
+		if id == nil {
+			continue
+		}
+		if _, ok := desiredSet[*id]; !ok {
+			toRemove = append(toRemove, *id)
+			desiredSet[*id] = struct{}{}
+		}
+	}
+	return toAdd, toRemove
+}
+
+// syncAssociations disassociates the attachments that are no longer desired
+// from the route table and associates the new ones. An attachment can only be
+// associated with a single route table, so disassociations are issued first.
+func (rm *resourceManager) syncAssociations(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncAssociations")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	rtID := latest.ko.Status.TransitGatewayRouteTableID
+	toAdd, toRemove := getAttachmentIDsDifference(
+		desired.ko.Spec.Associations, latest.ko.Spec.Associations,
+	)
+
+	for _, attachmentID := range toRemove {
+		if err = rm.disassociateAttachment(ctx, *rtID, attachmentID); err != nil {
+			return err
+		}
+	}
+	for _, attachmentID := range toAdd {
+		if err = rm.associateAttachment(ctx, *rtID, attachmentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) associateAttachment(
+	ctx context.Context,
+	rtID string,
+	attachmentID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.associateAttachment")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.AssociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: &attachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.AssociateTransitGatewayRouteTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AssociateTransitGatewayRouteTable", err)
+	return err
+}
+
+func (rm *resourceManager) disassociateAttachment(
+	ctx context.Context,
+	rtID string,
+	attachmentID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disassociateAttachment")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayAttachmentId: &attachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.DisassociateTransitGatewayRouteTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DisassociateTransitGatewayRouteTable", err)
+	return err
+}
+
+// syncPropagations disables route propagation from the attachments that are
+// no longer desired and enables it for the new ones.
+func (rm *resourceManager) syncPropagations(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncPropagations")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	rtID := latest.ko.Status.TransitGatewayRouteTableID
+	toAdd, toRemove := getAttachmentIDsDifference(
+		desired.ko.Spec.Propagations, latest.ko.Spec.Propagations,
+	)
+
+	for _, attachmentID := range toRemove {
+		if err = rm.disablePropagation(ctx, *rtID, attachmentID); err != nil {
+			return err
+		}
+	}
+	for _, attachmentID := range toAdd {
+		if err = rm.enablePropagation(ctx, *rtID, attachmentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) enablePropagation(
+	ctx context.Context,
+	rtID string,
+	attachmentID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.enablePropagation")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayAttachmentId: &attachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.EnableTransitGatewayRouteTablePropagation(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "EnableTransitGatewayRouteTablePropagation", err)
+	return err
+}
+
+func (rm *resourceManager) disablePropagation(
+	ctx context.Context,
+	rtID string,
+	attachmentID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disablePropagation")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayAttachmentId: &attachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.DisableTransitGatewayRouteTablePropagation(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DisableTransitGatewayRouteTablePropagation", err)
+	return err
+}
+
+// getAssociations returns the IDs of the transit gateway attachments that
+// are associated, or being associated, with the route table.
+func (rm *resourceManager) getAssociations(
+	ctx context.Context,
+	r *resource,
+) (attachmentIDs []*string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getAssociations")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: r.ko.Status.TransitGatewayRouteTableID,
+	}
+	for {
+		resp, err := rm.sdkapi.GetTransitGatewayRouteTableAssociations(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "GetTransitGatewayRouteTableAssociations", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, assoc := range resp.Associations {
+			if assoc.State == svcsdktypes.TransitGatewayAssociationStateDisassociating ||
+				assoc.State == svcsdktypes.TransitGatewayAssociationStateDisassociated {
+				continue
+			}
+			attachmentIDs = append(attachmentIDs, assoc.TransitGatewayAttachmentId)
+		}
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return attachmentIDs, nil
+}
+
+// getPropagations returns the IDs of the transit gateway attachments that
+// propagate, or are being enabled to propagate, routes to the route table.
+func (rm *resourceManager) getPropagations(
+	ctx context.Context,
+	r *resource,
+) (attachmentIDs []*string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getPropagations")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: r.ko.Status.TransitGatewayRouteTableID,
+	}
+	for {
+		resp, err := rm.sdkapi.GetTransitGatewayRouteTablePropagations(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "GetTransitGatewayRouteTablePropagations", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, prop := range resp.TransitGatewayRouteTablePropagations {
+			if prop.State == svcsdktypes.TransitGatewayPropagationStateDisabling ||
+				prop.State == svcsdktypes.TransitGatewayPropagationStateDisabled {
+				continue
+			}
+			attachmentIDs = append(attachmentIDs, prop.TransitGatewayAttachmentId)
+		}
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return attachmentIDs, nil
+}
+
+// getRoutesDifference compares the desired and latest static routes by
+// destination CIDR block. It returns the routes that must be created, the
+// routes whose target changed and must be replaced, and the routes that must
+// be deleted.
+func getRoutesDifference(
+	desired []*svcapitypes.CreateTransitGatewayRouteInput,
+	latest []*svcapitypes.CreateTransitGatewayRouteInput,
+) (toCreate, toReplace, toDelete []*svcapitypes.CreateTransitGatewayRouteInput) {
+	latestByDestination := map[string]*svcapitypes.CreateTransitGatewayRouteInput{}
+	for _, route := range latest {
+		if route == nil || route.DestinationCIDRBlock == nil {
+			continue
+		}
+		latestByDestination[*route.DestinationCIDRBlock] = route
+	}
+	desiredDestinations := map[string]struct{}{}
+	for _, route := range desired {
+		if route == nil || route.DestinationCIDRBlock == nil {
+			continue
+		}
+		desiredDestinations[*route.DestinationCIDRBlock] = struct{}{}
+		existing, found := latestByDestination[*route.DestinationCIDRBlock]
+		if !found {
+			toCreate = append(toCreate, route)
+		} else if !equalRouteTargets(route, existing) {
+			toReplace = append(toReplace, route)
+		}
+	}
+	for _, route := range latest {
+		if route == nil || route.DestinationCIDRBlock == nil {
+			continue
+		}
+		if _, found := desiredDestinations[*route.DestinationCIDRBlock]; !found {
+			toDelete = append(toDelete, route)
+		}
+	}
+	return toCreate, toReplace, toDelete
+}
+
+// equalRouteTargets returns true if both routes point at the same attachment
+// or are both blackhole routes. An unset Blackhole is equivalent to false.
+func equalRouteTargets(
+	a *svcapitypes.CreateTransitGatewayRouteInput,
+	b *svcapitypes.CreateTransitGatewayRouteInput,
+) bool {
+	if aws.ToBool(a.Blackhole) != aws.ToBool(b.Blackhole) {
+		return false
+	}
+	return aws.ToString(a.TransitGatewayAttachmentID) == aws.ToString(b.TransitGatewayAttachmentID)
+}
+
+// getRoutes returns the active, blackhole and pending routes of the route
+// table, both static and propagated.
+func (rm *resourceManager) getRoutes(
+	ctx context.Context,
+	r *resource,
+) (routes []svcsdktypes.TransitGatewayRoute, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getRoutes")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	return searchRoutes(ctx, rm.sdkapi, rm.metrics, aws.ToString(r.ko.Status.TransitGatewayRouteTableID))
+}
+
+type routesSearcher interface {
+	SearchTransitGatewayRoutes(context.Context, *svcsdk.SearchTransitGatewayRoutesInput, ...func(*svcsdk.Options)) (*svcsdk.SearchTransitGatewayRoutesOutput, error)
+}
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+var (
+	searchedRouteStates = []svcsdktypes.TransitGatewayRouteState{
+		svcsdktypes.TransitGatewayRouteStateActive,
+		svcsdktypes.TransitGatewayRouteStateBlackhole,
+		svcsdktypes.TransitGatewayRouteStatePending,
+	}
+	searchedRouteTypes = []svcsdktypes.TransitGatewayRouteType{
+		svcsdktypes.TransitGatewayRouteTypeStatic,
+		svcsdktypes.TransitGatewayRouteTypePropagated,
+	}
+)
+
+// searchRoutes returns the routes of the route table in any of the
+// searchedRouteStates. SearchTransitGatewayRoutes does not paginate and caps
+// its results at MaxResults, so when more routes are available the search is
+// narrowed to one route type and state at a time. If a narrowed search is
+// still capped, an error is returned rather than a partial list of routes.
+func searchRoutes(
+	ctx context.Context,
+	client routesSearcher,
+	mr metricsRecorder,
+	rtID string,
+) ([]svcsdktypes.TransitGatewayRoute, error) {
+	routes, more, err := searchRoutesWithFilters(ctx, client, mr, rtID, searchedRouteStates, "")
+	if err != nil || !more {
+		return routes, err
+	}
+
+	routes = nil
+	for _, routeType := range searchedRouteTypes {
+		for _, state := range searchedRouteStates {
+			found, more, err := searchRoutesWithFilters(
+				ctx, client, mr, rtID,
+				[]svcsdktypes.TransitGatewayRouteState{state}, routeType,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if more {
+				return nil, fmt.Errorf(
+					"TransitGatewayRouteTable %s has more %s routes in state %s than "+
+						"SearchTransitGatewayRoutes can return",
+					rtID, routeType, state,
+				)
+			}
+			routes = append(routes, found...)
+		}
+	}
+	return routes, nil
+}
+
+// searchRoutesWithFilters returns the routes of the route table in the
+// supplied states and, if routeType is not empty, of that type. It also
+// reports whether EC2 had more matching routes than it returned.
+func searchRoutesWithFilters(
+	ctx context.Context,
+	client routesSearcher,
+	mr metricsRecorder,
+	rtID string,
+	states []svcsdktypes.TransitGatewayRouteState,
+	routeType svcsdktypes.TransitGatewayRouteType,
+) ([]svcsdktypes.TransitGatewayRoute, bool, error) {
+	stateValues := make([]string, 0, len(states))
+	for _, state := range states {
+		stateValues = append(stateValues, string(state))
+	}
+	input := &svcsdk.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: &rtID,
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: stateValues,
+			},
+		},
+	}
+	if routeType != "" {
+		input.Filters = append(input.Filters, svcsdktypes.Filter{
+			Name:   aws.String("type"),
+			Values: []string{string(routeType)},
+		})
+	}
+	resp, err := client.SearchTransitGatewayRoutes(ctx, input)
+	mr.RecordAPICall("READ_MANY", "SearchTransitGatewayRoutes", err)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Routes, aws.ToBool(resp.AdditionalRoutesAvailable), nil
+}
+
+func (rm *resourceManager) addRoutesToStatus(
+	ko *svcapitypes.TransitGatewayRouteTable,
+	routes []svcsdktypes.TransitGatewayRoute,
+) {
+	ko.Status.RouteStatuses = nil
+	if routes != nil {
+		routesInStatus := []*svcapitypes.TransitGatewayRoute{}
+		for _, r := range routes {
+			routesInStatus = append(routesInStatus, rm.setResourceTransitGatewayRoute(r))
+		}
+		ko.Status.RouteStatuses = routesInStatus
+	}
+}
+
+// getStaticRoutes returns the static routes among the supplied route statuses
+// in their Spec representation. Propagated routes are managed by EC2 and are
+// only reported in Status.
+func getStaticRoutes(
+	routes []*svcapitypes.TransitGatewayRoute,
+) []*svcapitypes.CreateTransitGatewayRouteInput {
+	var staticRoutes []*svcapitypes.CreateTransitGatewayRouteInput
+	for _, route := range routes {
+		if route.Type == nil || *route.Type != string(svcsdktypes.TransitGatewayRouteTypeStatic) {
+			continue
+		}
+		if route.DestinationCIDRBlock == nil {
+			continue
+		}
+		staticRoute := &svcapitypes.CreateTransitGatewayRouteInput{
+			DestinationCIDRBlock: route.DestinationCIDRBlock,
+		}
+		if len(route.TransitGatewayAttachments) > 0 {
+			staticRoute.TransitGatewayAttachmentID = route.TransitGatewayAttachments[0].TransitGatewayAttachmentID
+		} else if route.State != nil && *route.State == string(svcsdktypes.TransitGatewayRouteStateBlackhole) {
+			staticRoute.Blackhole = aws.Bool(true)
+		}
+		staticRoutes = append(staticRoutes, staticRoute)
+	}
+	return staticRoutes
+}
+
+// syncRoutes deletes the static routes that are no longer desired, replaces
+// the routes whose target changed and creates the new ones.
+func (rm *resourceManager) syncRoutes(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncRoutes")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	rtID := latest.ko.Status.TransitGatewayRouteTableID
+	toCreate, toReplace, toDelete := getRoutesDifference(
+		desired.ko.Spec.Routes, latest.ko.Spec.Routes,
+	)
+
+	for _, route := range toDelete {
+		rlog.Debug("deleting route from transit gateway route table")
+		if err = rm.deleteRoute(ctx, *rtID, *route); err != nil {
+			return err
+		}
+	}
+	for _, route := range toReplace {
+		rlog.Debug("replacing route in transit gateway route table")
+		if err = rm.replaceRoute(ctx, *rtID, *route); err != nil {
+			return err
+		}
+	}
+	for _, route := range toCreate {
+		rlog.Debug("adding route to transit gateway route table")
+		if err = rm.createRoute(ctx, *rtID, *route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) createRoute(
+	ctx context.Context,
+	rtID string,
+	c svcapitypes.CreateTransitGatewayRouteInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.createRoute")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.CreateTransitGatewayRouteInput{
+		Blackhole:                  c.Blackhole,
+		DestinationCidrBlock:       c.DestinationCIDRBlock,
+		TransitGatewayAttachmentId: c.TransitGatewayAttachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.CreateTransitGatewayRoute(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateTransitGatewayRoute", err)
+	return err
+}
+
+func (rm *resourceManager) replaceRoute(
+	ctx context.Context,
+	rtID string,
+	c svcapitypes.CreateTransitGatewayRouteInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.replaceRoute")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.ReplaceTransitGatewayRouteInput{
+		Blackhole:                  c.Blackhole,
+		DestinationCidrBlock:       c.DestinationCIDRBlock,
+		TransitGatewayAttachmentId: c.TransitGatewayAttachmentID,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.ReplaceTransitGatewayRoute(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ReplaceTransitGatewayRoute", err)
+	return err
+}
+
+func (rm *resourceManager) deleteRoute(
+	ctx context.Context,
+	rtID string,
+	c svcapitypes.CreateTransitGatewayRouteInput,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deleteRoute")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DeleteTransitGatewayRouteInput{
+		DestinationCidrBlock:       c.DestinationCIDRBlock,
+		TransitGatewayRouteTableId: &rtID,
+	}
+	_, err = rm.sdkapi.DeleteTransitGatewayRoute(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "DeleteTransitGatewayRoute", err)
+	return err
+}
+
+// removeAssociationsAndPropagations disables every propagation and removes
+// every association of the route table, which EC2 requires before the route
+// table can be deleted. The associations and propagations are read from EC2
+// rather than from the Spec, because the ones still disassociating or
+// disabling are hidden from the Spec. The deletion is requeued until none of
+// them remain.
+func (rm *resourceManager) removeAssociationsAndPropagations(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.removeAssociationsAndPropagations")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	rtID := r.ko.Status.TransitGatewayRouteTableID
+	if rtID == nil {
+		return nil
+	}
+	pending := false
+
+	propInput := &svcsdk.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: rtID,
+	}
+	for {
+		resp, err := rm.sdkapi.GetTransitGatewayRouteTablePropagations(ctx, propInput)
+		rm.metrics.RecordAPICall("READ_MANY", "GetTransitGatewayRouteTablePropagations", err)
+		if err != nil {
+			return err
+		}
+		for _, prop := range resp.TransitGatewayRouteTablePropagations {
+			switch prop.State {
+			case svcsdktypes.TransitGatewayPropagationStateDisabled:
+				continue
+			case svcsdktypes.TransitGatewayPropagationStateEnabled:
+				if err = rm.disablePropagation(ctx, *rtID, *prop.TransitGatewayAttachmentId); err != nil {
+					return err
+				}
+			}
+			pending = true
+		}
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		propInput.NextToken = resp.NextToken
+	}
+
+	assocInput := &svcsdk.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: rtID,
+	}
+	for {
+		resp, err := rm.sdkapi.GetTransitGatewayRouteTableAssociations(ctx, assocInput)
+		rm.metrics.RecordAPICall("READ_MANY", "GetTransitGatewayRouteTableAssociations", err)
+		if err != nil {
+			return err
+		}
+		for _, assoc := range resp.Associations {
+			switch assoc.State {
+			case svcsdktypes.TransitGatewayAssociationStateDisassociated:
+				continue
+			case svcsdktypes.TransitGatewayAssociationStateAssociated:
+				if err = rm.disassociateAttachment(ctx, *rtID, *assoc.TransitGatewayAttachmentId); err != nil {
+					return err
+				}
+			}
+			pending = true
+		}
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		assocInput.NextToken = resp.NextToken
+	}
+
+	if pending {
+		return requeueWaitWhileDisassociating
+	}
+	return nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateTransitGatewayRouteTableInput.TagSpecification
+// and ensures the ResourceType is always set to 'transit-gateway-route-table'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateTransitGatewayRouteTableInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "transit-gateway-route-table"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,262 @@
+package transit_gateway_route_table
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPreCompare(t *testing.T) {
+	createTestResource := func(associations, propagations []string) *resource {
+		return &resource{
+			ko: &svcapitypes.TransitGatewayRouteTable{
+				Spec: svcapitypes.TransitGatewayRouteTableSpec{
+					Associations: aws.StringSlice(associations),
+					Propagations: aws.StringSlice(propagations),
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		id                   string
+		desiredAssociations  []string
+		latestAssociations   []string
+		desiredPropagations  []string
+		latestPropagations   []string
+		associationsToAdd    []string
+		associationsToRemove []string
+		associationsDiffer   bool
+		propagationsDiffer   bool
+	}{
+		{"all identical",
+			[]string{"tgw-attach-1"}, []string{"tgw-attach-1"},
+			[]string{"tgw-attach-1"}, []string{"tgw-attach-1"},
+			nil, nil,
+			false, false,
+		},
+		{"different order",
+			[]string{"tgw-attach-1", "tgw-attach-2"}, []string{"tgw-attach-2", "tgw-attach-1"},
+			[]string{"tgw-attach-2", "tgw-attach-1"}, []string{"tgw-attach-1", "tgw-attach-2"},
+			nil, nil,
+			false, false,
+		},
+		{"add association",
+			[]string{"tgw-attach-1", "tgw-attach-2"}, []string{"tgw-attach-1"},
+			nil, nil,
+			[]string{"tgw-attach-2"}, nil,
+			true, false,
+		},
+		{"remove association",
+			nil, []string{"tgw-attach-1"},
+			nil, nil,
+			nil, []string{"tgw-attach-1"},
+			true, false,
+		},
+		{"add one remove one",
+			[]string{"tgw-attach-1", "tgw-attach-2"}, []string{"tgw-attach-1", "tgw-attach-3"},
+			nil, nil,
+			[]string{"tgw-attach-2"}, []string{"tgw-attach-3"},
+			true, false,
+		},
+		{"propagation only",
+			nil, nil,
+			[]string{"tgw-attach-1"}, nil,
+			nil, nil,
+			false, true,
+		},
+		{"remove propagation",
+			[]string{"tgw-attach-1"}, []string{"tgw-attach-1"},
+			nil, []string{"tgw-attach-1"},
+			nil, nil,
+			false, true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := createTestResource(tc.desiredAssociations, tc.desiredPropagations)
+			b := createTestResource(tc.latestAssociations, tc.latestPropagations)
+
+			toAdd, toRemove := getAttachmentIDsDifference(a.ko.Spec.Associations, b.ko.Spec.Associations)
+			assert.Equal(t, tc.associationsToAdd, toAdd)
+			assert.Equal(t, tc.associationsToRemove, toRemove)
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, tc.associationsDiffer, delta.DifferentAt("Spec.Associations"))
+			assert.Equal(t, tc.propagationsDiffer, delta.DifferentAt("Spec.Propagations"))
+			assert.False(t, delta.DifferentAt("Spec.Routes"))
+		})
+	}
+}
+
+func TestGetRoutesDifference(t *testing.T) {
+	type Routes []*svcapitypes.CreateTransitGatewayRouteInput
+
+	attachmentRoute := func(attachmentID string, cidr string) *svcapitypes.CreateTransitGatewayRouteInput {
+		return &svcapitypes.CreateTransitGatewayRouteInput{
+			DestinationCIDRBlock:       aws.String(cidr),
+			TransitGatewayAttachmentID: aws.String(attachmentID),
+		}
+	}
+	blackholeRoute := func(cidr string) *svcapitypes.CreateTransitGatewayRouteInput {
+		return &svcapitypes.CreateTransitGatewayRouteInput{
+			DestinationCIDRBlock: aws.String(cidr),
+			Blackhole:            aws.Bool(true),
+		}
+	}
+
+	tt := []struct {
+		id        string
+		desired   Routes
+		latest    Routes
+		toCreate  Routes
+		toReplace Routes
+		toDelete  Routes
+	}{
+		{"all identical",
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16"), blackholeRoute("10.2.0.0/16")},
+			Routes{blackholeRoute("10.2.0.0/16"), attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil, nil, nil,
+		},
+		{"create route",
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil,
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")}, nil, nil,
+		},
+		{"delete route",
+			nil,
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil, nil, Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+		},
+		{"replace attachment",
+			Routes{attachmentRoute("tgw-attach-2", "10.1.0.0/16")},
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil, Routes{attachmentRoute("tgw-attach-2", "10.1.0.0/16")}, nil,
+		},
+		{"replace with blackhole",
+			Routes{blackholeRoute("10.1.0.0/16")},
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil, Routes{blackholeRoute("10.1.0.0/16")}, nil,
+		},
+		{"unset blackhole equals false",
+			Routes{{DestinationCIDRBlock: aws.String("10.1.0.0/16"), TransitGatewayAttachmentID: aws.String("tgw-attach-1"), Blackhole: aws.Bool(false)}},
+			Routes{attachmentRoute("tgw-attach-1", "10.1.0.0/16")},
+			nil, nil, nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			toCreate, toReplace, toDelete := getRoutesDifference(tc.desired, tc.latest)
+			assert.Equal(t, tc.toCreate, Routes(toCreate))
+			assert.Equal(t, tc.toReplace, Routes(toReplace))
+			assert.Equal(t, tc.toDelete, Routes(toDelete))
+		})
+	}
+}
+
+type fakeRoutesSearcher struct {
+	search func(states []string, routeType string) *svcsdk.SearchTransitGatewayRoutesOutput
+	calls  int
+}
+
+func (c *fakeRoutesSearcher) SearchTransitGatewayRoutes(
+	_ context.Context,
+	input *svcsdk.SearchTransitGatewayRoutesInput,
+	_ ...func(*svcsdk.Options),
+) (*svcsdk.SearchTransitGatewayRoutesOutput, error) {
+	c.calls++
+	var states []string
+	routeType := ""
+	for _, f := range input.Filters {
+		switch *f.Name {
+		case "state":
+			states = f.Values
+		case "type":
+			routeType = f.Values[0]
+		}
+	}
+	return c.search(states, routeType), nil
+}
+
+type fakeMetricsRecorder struct{}
+
+func (fakeMetricsRecorder) RecordAPICall(string, string, error) {}
+
+func TestSearchRoutes(t *testing.T) {
+	route := func(cidr string) svcsdktypes.TransitGatewayRoute {
+		return svcsdktypes.TransitGatewayRoute{DestinationCidrBlock: aws.String(cidr)}
+	}
+
+	t.Run("all routes returned at once", func(t *testing.T) {
+		client := &fakeRoutesSearcher{
+			search: func(states []string, routeType string) *svcsdk.SearchTransitGatewayRoutesOutput {
+				return &svcsdk.SearchTransitGatewayRoutesOutput{
+					Routes:                    []svcsdktypes.TransitGatewayRoute{route("10.0.0.0/16")},
+					AdditionalRoutesAvailable: aws.Bool(false),
+				}
+			},
+		}
+		routes, err := searchRoutes(context.TODO(), client, fakeMetricsRecorder{}, "tgw-rtb-1")
+		assert.Nil(t, err)
+		assert.Len(t, routes, 1)
+		assert.Equal(t, 1, client.calls)
+	})
+
+	t.Run("capped search is narrowed", func(t *testing.T) {
+		client := &fakeRoutesSearcher{
+			search: func(states []string, routeType string) *svcsdk.SearchTransitGatewayRoutesOutput {
+				if routeType == "" {
+					return &svcsdk.SearchTransitGatewayRoutesOutput{
+						Routes:                    []svcsdktypes.TransitGatewayRoute{route("10.0.0.0/16")},
+						AdditionalRoutesAvailable: aws.Bool(true),
+					}
+				}
+				if routeType == string(svcsdktypes.TransitGatewayRouteTypeStatic) &&
+					states[0] == string(svcsdktypes.TransitGatewayRouteStateActive) {
+					return &svcsdk.SearchTransitGatewayRoutesOutput{
+						Routes: []svcsdktypes.TransitGatewayRoute{
+							route("10.0.0.0/16"), route("10.1.0.0/16"),
+						},
+					}
+				}
+				if routeType == string(svcsdktypes.TransitGatewayRouteTypePropagated) &&
+					states[0] == string(svcsdktypes.TransitGatewayRouteStateBlackhole) {
+					return &svcsdk.SearchTransitGatewayRoutesOutput{
+						Routes: []svcsdktypes.TransitGatewayRoute{route("10.2.0.0/16")},
+					}
+				}
+				return &svcsdk.SearchTransitGatewayRoutesOutput{}
+			},
+		}
+		routes, err := searchRoutes(context.TODO(), client, fakeMetricsRecorder{}, "tgw-rtb-1")
+		assert.Nil(t, err)
+		assert.Equal(t, []svcsdktypes.TransitGatewayRoute{
+			route("10.0.0.0/16"), route("10.1.0.0/16"), route("10.2.0.0/16"),
+		}, routes)
+		assert.Equal(t, 7, client.calls)
+	})
+
+	t.Run("narrowed search still capped", func(t *testing.T) {
+		client := &fakeRoutesSearcher{
+			search: func(states []string, routeType string) *svcsdk.SearchTransitGatewayRoutesOutput {
+				return &svcsdk.SearchTransitGatewayRoutesOutput{
+					Routes:                    []svcsdktypes.TransitGatewayRoute{route("10.0.0.0/16")},
+					AdditionalRoutesAvailable: aws.Bool(true),
+				}
+			},
+		}
+		routes, err := searchRoutes(context.TODO(), client, fakeMetricsRecorder{}, "tgw-rtb-1")
+		assert.NotNil(t, err)
+		assert.Nil(t, routes)
+	})
+}
@@ -0,0 +1,459 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrAttachmentDetaching = fmt.Errorf(
+		"Volume attachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.VolumeAttachmentStateDetaching,
+	)
+	ErrVolumeCreating = fmt.Errorf(
+		"Volume in '%v' state, cannot be attached",
+		svcsdktypes.VolumeStateCreating,
+	)
+	ErrModificationInProgress = fmt.Errorf(
+		"Volume modification in progress, cannot be modified",
+	)
+)
+
+var (
+	requeueWaitWhileDetaching = ackrequeue.NeededAfter(
+		ErrAttachmentDetaching,
+		10*time.Second,
+	)
+	requeueWaitWhileCreating = ackrequeue.NeededAfter(
+		ErrVolumeCreating,
+		5*time.Second,
+	)
+	requeueWaitWhileModifying = ackrequeue.NeededAfter(
+		ErrModificationInProgress,
+		time.Minute,
+	)
+)
+
+// checkForMissingRequiredFields returns true if the VolumeID used to describe
+// the resource has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.VolumeID == nil
+}
+
+// isVolumeDeleted returns true if the volume has been deleted. Deleted volumes
+// are still returned by DescribeVolumes for a short while.
+func isVolumeDeleted(r *resource) bool {
+	return r.ko.Status.State != nil &&
+		*r.ko.Status.State == string(svcsdktypes.VolumeStateDeleted)
+}
+
+// isVolumeModifying returns true if a ModifyVolume request is still being
+// applied to the volume. Once the modification reaches the optimizing state
+// the volume reports the target size, IOPS, throughput and type.
+func isVolumeModifying(r *resource) bool {
+	mod := r.ko.Status.Modification
+	return mod != nil && mod.ModificationState != nil &&
+		*mod.ModificationState == string(svcsdktypes.VolumeModificationStateModifying)
+}
+
+// isModificationInProgress returns true if a previous modification has not
+// completed yet, in which case EC2 rejects further ModifyVolume requests.
+func isModificationInProgress(r *resource) bool {
+	mod := r.ko.Status.Modification
+	if mod == nil || mod.ModificationState == nil {
+		return false
+	}
+	return *mod.ModificationState == string(svcsdktypes.VolumeModificationStateModifying) ||
+		*mod.ModificationState == string(svcsdktypes.VolumeModificationStateOptimizing)
+}
+
+// isAttachmentDetaching returns true if any attachment of the volume is still
+// being detached from an instance.
+func isAttachmentDetaching(r *resource) bool {
+	for _, att := range r.ko.Status.Attachments {
+		if att.State != nil && *att.State == string(svcsdktypes.VolumeAttachmentStateDetaching) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAttachments returns the attachments of the volume that are attached, or
+// being attached, to an instance.
+func getAttachments(r *resource) []*svcapitypes.VolumeAttachment {
+	attachments := []*svcapitypes.VolumeAttachment{}
+	for _, att := range r.ko.Status.Attachments {
+		if att.State == nil {
+			continue
+		}
+		if *att.State == string(svcsdktypes.VolumeAttachmentStateAttached) ||
+			*att.State == string(svcsdktypes.VolumeAttachmentStateAttaching) {
+			attachments = append(attachments, att)
+		}
+	}
+	return attachments
+}
+
+// getAttachment returns the attachment managed through Spec.InstanceID. Only
+// a single attachment is managed; for Multi-Attach volumes, the attachment to
+// the instance in Spec.InstanceID is preferred.
+func getAttachment(r *resource) *svcapitypes.VolumeAttachment {
+	attachments := getAttachments(r)
+	if len(attachments) == 0 {
+		return nil
+	}
+	for _, att := range attachments {
+		if r.ko.Spec.InstanceID != nil && aws.ToString(att.InstanceID) == *r.ko.Spec.InstanceID {
+			return att
+		}
+	}
+	return attachments[0]
+}
+
+// setAttachment sets Spec.InstanceID and Spec.Device from the observed
+// attachment of the volume.
+func setAttachment(ko *svcapitypes.Volume) {
+	att := getAttachment(&resource{ko})
+	ko.Spec.InstanceID = nil
+	ko.Spec.Device = nil
+	if att != nil {
+		ko.Spec.InstanceID = att.InstanceID
+		ko.Spec.Device = att.Device
+	}
+}
+
+// setModification sets Status.Modification from the most recent modification
+// of the volume, if any.
+func (rm *resourceManager) setModification(
+	ctx context.Context,
+	ko *svcapitypes.Volume,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setModification")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Filtering on the volume ID, rather than passing it in VolumeIds, avoids
+	// an error for volumes that have never been modified.
+	input := &svcsdk.DescribeVolumesModificationsInput{
+		Filters: []svcsdktypes.Filter{
+			{
+				Name:   aws.String("volume-id"),
+				Values: []string{*ko.Status.VolumeID},
+			},
+		},
+	}
+	resp, err := rm.sdkapi.DescribeVolumesModifications(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeVolumesModifications", err)
+	if err != nil {
+		return err
+	}
+
+	ko.Status.Modification = nil
+	if len(resp.VolumesModifications) > 0 {
+		ko.Status.Modification = newVolumeModification(resp.VolumesModifications[0])
+	}
+	return nil
+}
+
+// newVolumeModification converts a VolumeModification returned by EC2 into its
+// custom resource representation.
+func newVolumeModification(
+	mod svcsdktypes.VolumeModification,
+) *svcapitypes.VolumeModification {
+	res := &svcapitypes.VolumeModification{
+		OriginalIOPS:               int32ToInt64(mod.OriginalIops),
+		OriginalMultiAttachEnabled: mod.OriginalMultiAttachEnabled,
+		OriginalSize:               int32ToInt64(mod.OriginalSize),
+		OriginalThroughput:         int32ToInt64(mod.OriginalThroughput),
+		Progress:                   mod.Progress,
+		StatusMessage:              mod.StatusMessage,
+		TargetIOPS:                 int32ToInt64(mod.TargetIops),
+		TargetMultiAttachEnabled:   mod.TargetMultiAttachEnabled,
+		TargetSize:                 int32ToInt64(mod.TargetSize),
+		TargetThroughput:           int32ToInt64(mod.TargetThroughput),
+		VolumeID:                   mod.VolumeId,
+	}
+	if mod.ModificationState != "" {
+		res.ModificationState = aws.String(string(mod.ModificationState))
+	}
+	if mod.OriginalVolumeType != "" {
+		res.OriginalVolumeType = aws.String(string(mod.OriginalVolumeType))
+	}
+	if mod.TargetVolumeType != "" {
+		res.TargetVolumeType = aws.String(string(mod.TargetVolumeType))
+	}
+	if mod.StartTime != nil {
+		res.StartTime = &metav1.Time{Time: *mod.StartTime}
+	}
+	if mod.EndTime != nil {
+		res.EndTime = &metav1.Time{Time: *mod.EndTime}
+	}
+	return res
+}
+
+func int32ToInt64(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Int64(int64(*v))
+}
+
+// customPreCompare only compares the device name while the volume is meant to
+// be attached.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.InstanceID != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.Device, b.ko.Spec.Device) {
+			delta.Add("Spec.Device", a.ko.Spec.Device, b.ko.Spec.Device)
+		} else if a.ko.Spec.Device != nil && b.ko.Spec.Device != nil {
+			if *a.ko.Spec.Device != *b.ko.Spec.Device {
+				delta.Add("Spec.Device", a.ko.Spec.Device, b.ko.Spec.Device)
+			}
+		}
+	}
+}
+
+func (rm *resourceManager) customUpdateVolume(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateVolume")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.InstanceID") || delta.DifferentAt("Spec.Device") {
+		if err = rm.syncAttachment(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.VolumeID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Size") || delta.DifferentAt("Spec.IOPS") ||
+		delta.DifferentAt("Spec.Throughput") || delta.DifferentAt("Spec.VolumeType") ||
+		delta.DifferentAt("Spec.MultiAttachEnabled") {
+		if isModificationInProgress(latest) {
+			return nil, requeueWaitWhileModifying
+		}
+		mod, err := rm.modifyVolume(ctx, desired, latest, delta)
+		if err != nil {
+			return nil, err
+		}
+		updated.ko.Status.Modification = mod
+	}
+
+	return updated, nil
+}
+
+// modifyVolume requests the changes to the size, IOPS, throughput, type and
+// Multi-Attach setting of the volume. Only the changed fields are sent, since
+// EC2 rejects e.g. IOPS for volume types that do not support them.
+func (rm *resourceManager) modifyVolume(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (mod *svcapitypes.VolumeModification, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.modifyVolume")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.ModifyVolumeInput{
+		VolumeId: latest.ko.Status.VolumeID,
+	}
+	if delta.DifferentAt("Spec.Size") && desired.ko.Spec.Size != nil {
+		input.Size = aws.Int32(int32(*desired.ko.Spec.Size))
+	}
+	if delta.DifferentAt("Spec.IOPS") && desired.ko.Spec.IOPS != nil {
+		input.Iops = aws.Int32(int32(*desired.ko.Spec.IOPS))
+	}
+	if delta.DifferentAt("Spec.Throughput") && desired.ko.Spec.Throughput != nil {
+		input.Throughput = aws.Int32(int32(*desired.ko.Spec.Throughput))
+	}
+	if delta.DifferentAt("Spec.VolumeType") && desired.ko.Spec.VolumeType != nil {
+		input.VolumeType = svcsdktypes.VolumeType(*desired.ko.Spec.VolumeType)
+	}
+	if delta.DifferentAt("Spec.MultiAttachEnabled") && desired.ko.Spec.MultiAttachEnabled != nil {
+		input.MultiAttachEnabled = desired.ko.Spec.MultiAttachEnabled
+	}
+
+	resp, err := rm.sdkapi.ModifyVolume(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyVolume", err)
+	if err != nil {
+		return nil, err
+	}
+	if resp.VolumeModification == nil {
+		return nil, nil
+	}
+	return newVolumeModification(*resp.VolumeModification), nil
+}
+
+// syncAttachment attaches the volume to the desired instance as the desired
+// device, detaching it from the currently attached instance first. The new
+// attachment is only created once the old one is fully detached.
+func (rm *resourceManager) syncAttachment(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncAttachment")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	if isAttachmentDetaching(latest) {
+		return requeueWaitWhileDetaching
+	}
+
+	if att := getAttachment(latest); att != nil {
+		if err = rm.detachVolume(ctx, *latest.ko.Status.VolumeID, att); err != nil {
+			return err
+		}
+		if desired.ko.Spec.InstanceID != nil {
+			return requeueWaitWhileDetaching
+		}
+		return nil
+	}
+
+	if desired.ko.Spec.InstanceID != nil {
+		if desired.ko.Spec.Device == nil {
+			return ackerr.NewTerminalError(fmt.Errorf("field Device is required to attach the volume"))
+		}
+		if aws.ToString(latest.ko.Status.State) == string(svcsdktypes.VolumeStateCreating) {
+			return requeueWaitWhileCreating
+		}
+		if err = rm.attachVolume(ctx, desired, *latest.ko.Status.VolumeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) attachVolume(
+	ctx context.Context,
+	desired *resource,
+	volumeID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.attachVolume")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.AttachVolumeInput{
+		Device:     desired.ko.Spec.Device,
+		InstanceId: desired.ko.Spec.InstanceID,
+		VolumeId:   &volumeID,
+	}
+
+	_, err = rm.sdkapi.AttachVolume(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AttachVolume", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) detachVolume(
+	ctx context.Context,
+	volumeID string,
+	att *svcapitypes.VolumeAttachment,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.detachVolume")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DetachVolumeInput{
+		Device:     att.Device,
+		InstanceId: att.InstanceID,
+		VolumeId:   &volumeID,
+	}
+
+	_, err = rm.sdkapi.DetachVolume(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DetachVolume", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateVolumeInput.TagSpecification
+// and ensures the ResourceType is always set to 'volume'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateVolumeInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "volume"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,102 @@
+package volume
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomPreCompare(t *testing.T) {
+	tt := []struct {
+		id                string
+		desiredInstanceID *string
+		desiredDevice     *string
+		latestDevice      *string
+		deviceDiffers     bool
+	}{
+		{"device ignored while detached",
+			nil, aws.String("/dev/sdf"), nil,
+			false,
+		},
+		{"identical device",
+			aws.String("i-1"), aws.String("/dev/sdf"), aws.String("/dev/sdf"),
+			false,
+		},
+		{"device changed",
+			aws.String("i-1"), aws.String("/dev/sdg"), aws.String("/dev/sdf"),
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.Volume{Spec: svcapitypes.VolumeSpec{
+				InstanceID: tc.desiredInstanceID,
+				Device:     tc.desiredDevice,
+			}}}
+			b := &resource{ko: &svcapitypes.Volume{Spec: svcapitypes.VolumeSpec{
+				InstanceID: tc.desiredInstanceID,
+				Device:     tc.latestDevice,
+			}}}
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, tc.deviceDiffers, delta.DifferentAt("Spec.Device"))
+		})
+	}
+}
+
+func TestSetAttachment(t *testing.T) {
+	attachment := func(instanceID, device, state string) *svcapitypes.VolumeAttachment {
+		return &svcapitypes.VolumeAttachment{
+			InstanceID: aws.String(instanceID),
+			Device:     aws.String(device),
+			State:      aws.String(state),
+		}
+	}
+
+	tt := []struct {
+		id                string
+		desiredInstanceID *string
+		attachments       []*svcapitypes.VolumeAttachment
+		instanceID        *string
+		device            *string
+		detaching         bool
+	}{
+		{"not attached",
+			aws.String("i-1"), nil,
+			nil, nil, false,
+		},
+		{"attached",
+			nil, []*svcapitypes.VolumeAttachment{attachment("i-1", "/dev/sdf", "attached")},
+			aws.String("i-1"), aws.String("/dev/sdf"), false,
+		},
+		{"detaching",
+			aws.String("i-1"), []*svcapitypes.VolumeAttachment{attachment("i-1", "/dev/sdf", "detaching")},
+			nil, nil, true,
+		},
+		{"prefers desired instance",
+			aws.String("i-2"), []*svcapitypes.VolumeAttachment{
+				attachment("i-1", "/dev/sdf", "attached"),
+				attachment("i-2", "/dev/sdg", "attaching"),
+			},
+			aws.String("i-2"), aws.String("/dev/sdg"), false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			ko := &svcapitypes.Volume{
+				Spec:   svcapitypes.VolumeSpec{InstanceID: tc.desiredInstanceID},
+				Status: svcapitypes.VolumeStatus{Attachments: tc.attachments},
+			}
+			setAttachment(ko)
+			assert.Equal(t, tc.instanceID, ko.Spec.InstanceID)
+			assert.Equal(t, tc.device, ko.Spec.Device)
+			assert.Equal(t, tc.detaching, isAttachmentDetaching(&resource{ko}))
+		})
+	}
+}
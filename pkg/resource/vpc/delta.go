@@ -20,6 +20,7 @@ import (
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	"k8s.io/apimachinery/pkg/api/equality"
 )
 
 // Hack to avoid import errors during build...
@@ -98,6 +99,9 @@ func newResourceDelta(
 			delta.Add("Spec.IPv4IPAMPoolID", a.ko.Spec.IPv4IPAMPoolID, b.ko.Spec.IPv4IPAMPoolID)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.IPv4IPAMPoolRef, b.ko.Spec.IPv4IPAMPoolRef) {
+		delta.Add("Spec.IPv4IPAMPoolRef", a.ko.Spec.IPv4IPAMPoolRef, b.ko.Spec.IPv4IPAMPoolRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IPv4NetmaskLength, b.ko.Spec.IPv4NetmaskLength) {
 		delta.Add("Spec.IPv4NetmaskLength", a.ko.Spec.IPv4NetmaskLength, b.ko.Spec.IPv4NetmaskLength)
 	} else if a.ko.Spec.IPv4NetmaskLength != nil && b.ko.Spec.IPv4NetmaskLength != nil {
@@ -126,6 +130,9 @@ func newResourceDelta(
 			delta.Add("Spec.IPv6IPAMPoolID", a.ko.Spec.IPv6IPAMPoolID, b.ko.Spec.IPv6IPAMPoolID)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.IPv6IPAMPoolRef, b.ko.Spec.IPv6IPAMPoolRef) {
+		delta.Add("Spec.IPv6IPAMPoolRef", a.ko.Spec.IPv6IPAMPoolRef, b.ko.Spec.IPv6IPAMPoolRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IPv6NetmaskLength, b.ko.Spec.IPv6NetmaskLength) {
 		delta.Add("Spec.IPv6NetmaskLength", a.ko.Spec.IPv6NetmaskLength, b.ko.Spec.IPv6NetmaskLength)
 	} else if a.ko.Spec.IPv6NetmaskLength != nil && b.ko.Spec.IPv6NetmaskLength != nil {
@@ -16,15 +16,21 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/netip"
+	"strings"
 	"time"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
@@ -209,7 +215,9 @@ func computeStringPDifference(a, b []*string) (aNotB, bNotA []*string) {
 
 // syncCIDRBlocks analyzes desired and latest
 // IPv4 CIDRBlocks and executes API calls to
-// Associate/Disassociate CIDRs as needed
+// Associate/Disassociate CIDRs as needed. The associations returned by
+// the API are recorded in latest's Status.CIDRBlockAssociationSet with
+// their transitional state, so the caller can wait for them to settle.
 func (rm *resourceManager) syncCIDRBlocks(
 	ctx context.Context,
 	desired *resource,
@@ -217,28 +225,34 @@ func (rm *resourceManager) syncCIDRBlocks(
 ) (err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.syncCIDRBlocks")
-	defer exit(err)
+	defer func(err error) {
+		exit(err)
+	}(err)
 
 	desiredCIDRs := desired.ko.Spec.CIDRBlocks
 	latestCIDRs := latest.ko.Spec.CIDRBlocks
-	latestCIDRStates := latest.ko.Status.CIDRBlockAssociationSet
 	toAddCIDRs, toDeleteCIDRs := computeStringPDifference(desiredCIDRs, latestCIDRs)
-	cidrblockassociationset := []*svcapitypes.VPCCIDRBlockAssociation{}
 
-	// extract associationID for the DisassociateVpcCidr request
+	// extract associationID for the DisassociateVpcCidr request. Only
+	// associated blocks can be disassociated; failed associations have
+	// nothing to undo.
 	for _, cidr := range toDeleteCIDRs {
-
-		input := &svcsdk.DisassociateVpcCidrBlockInput{}
-		for _, cidrAssociation := range latestCIDRStates {
-			if *cidr == *cidrAssociation.CIDRBlock {
-				input.AssociationId = cidrAssociation.AssociationID
-				_, err = rm.sdkapi.DisassociateVpcCidrBlock(ctx, input)
-				rm.metrics.RecordAPICall("UPDATE", "DisassociateVpcCidrBlock", err)
-				if err != nil {
-					return err
-				}
-			} else {
-				cidrblockassociationset = append(cidrblockassociationset, cidrAssociation)
+		for i, cidrAssociation := range latest.ko.Status.CIDRBlockAssociationSet {
+			if cidrAssociation.CIDRBlock == nil || *cidr != *cidrAssociation.CIDRBlock ||
+				!cidrBlockStateIs(cidrAssociation.CIDRBlockState, svcsdktypes.VpcCidrBlockStateCodeAssociated) {
+				continue
+			}
+			input := &svcsdk.DisassociateVpcCidrBlockInput{
+				AssociationId: cidrAssociation.AssociationID,
+			}
+			var res *svcsdk.DisassociateVpcCidrBlockOutput
+			res, err = rm.sdkapi.DisassociateVpcCidrBlock(ctx, input)
+			rm.metrics.RecordAPICall("UPDATE", "DisassociateVpcCidrBlock", err)
+			if err != nil {
+				return err
+			}
+			if res.CidrBlockAssociation != nil {
+				latest.ko.Status.CIDRBlockAssociationSet[i] = newVPCCIDRBlockAssociation(res.CidrBlockAssociation)
 			}
 		}
 	}
@@ -249,53 +263,373 @@ func (rm *resourceManager) syncCIDRBlocks(
 			CidrBlock: cidr,
 		}
 		var res *svcsdk.AssociateVpcCidrBlockOutput
-		cidrblockassociation := &svcapitypes.VPCCIDRBlockAssociation{}
 		res, err = rm.sdkapi.AssociateVpcCidrBlock(ctx, input)
 		rm.metrics.RecordAPICall("UPDATE", "AssociateVpcCidrBlock", err)
 		if err != nil {
 			return err
 		}
 		if res.CidrBlockAssociation != nil {
-			if res.CidrBlockAssociation.AssociationId != nil {
-				cidrblockassociation.AssociationID = res.CidrBlockAssociation.AssociationId
+			latest.ko.Status.CIDRBlockAssociationSet = append(
+				latest.ko.Status.CIDRBlockAssociationSet,
+				newVPCCIDRBlockAssociation(res.CidrBlockAssociation),
+			)
+		}
+	}
+
+	return nil
+}
+
+// newVPCCIDRBlockAssociation returns the VPCCIDRBlockAssociation for an
+// IPv4 CIDR block association returned by the EC2 API
+func newVPCCIDRBlockAssociation(
+	assoc *svcsdktypes.VpcCidrBlockAssociation,
+) *svcapitypes.VPCCIDRBlockAssociation {
+	res := &svcapitypes.VPCCIDRBlockAssociation{
+		AssociationID: assoc.AssociationId,
+		CIDRBlock:     assoc.CidrBlock,
+	}
+	if assoc.CidrBlockState != nil {
+		res.CIDRBlockState = &svcapitypes.VPCCIDRBlockState{
+			StatusMessage: assoc.CidrBlockState.StatusMessage,
+		}
+		if assoc.CidrBlockState.State != "" {
+			res.CIDRBlockState.State = aws.String(string(assoc.CidrBlockState.State))
+		}
+	}
+	return res
+}
+
+// cidrBlockStateIs returns true if the CIDR block association state is one
+// of the given states.
+func cidrBlockStateIs(
+	s *svcapitypes.VPCCIDRBlockState,
+	states ...svcsdktypes.VpcCidrBlockStateCode,
+) bool {
+	if s == nil || s.State == nil {
+		return false
+	}
+	for _, state := range states {
+		if *s.State == string(state) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCIDRBlockFailed returns true if the CIDR block failed, or is failing,
+// to associate with the VPC.
+func isCIDRBlockFailed(s *svcapitypes.VPCCIDRBlockState) bool {
+	return cidrBlockStateIs(s,
+		svcsdktypes.VpcCidrBlockStateCodeFailing,
+		svcsdktypes.VpcCidrBlockStateCodeFailed,
+	)
+}
+
+// failedCIDRBlockMessage returns a description of a failed CIDR block
+// association including the reason reported by EC2.
+func failedCIDRBlockMessage(cidrBlock *string, s *svcapitypes.VPCCIDRBlockState) string {
+	msg := aws.ToString(cidrBlock) + ": " + aws.ToString(s.State)
+	if s.StatusMessage != nil {
+		msg += " (" + *s.StatusMessage + ")"
+	}
+	return msg
+}
+
+// amazonIPv6Pool is the IPv6 pool reported for Amazon-provided IPv6 CIDR
+// blocks.
+const amazonIPv6Pool = "Amazon"
+
+// reasonCIDRBlockAssociationFailed is the reason of the advisory condition
+// set when CIDR blocks failed to associate with the VPC.
+const reasonCIDRBlockAssociationFailed = "CIDRBlockAssociationFailed"
+
+// isIPv6CIDRBlockActive returns true if the IPv6 CIDR block is associated or
+// being associated with the VPC.
+func isIPv6CIDRBlockActive(assoc *svcapitypes.VPCIPv6CIDRBlockAssociation) bool {
+	return cidrBlockStateIs(assoc.IPv6CIDRBlockState,
+		svcsdktypes.VpcCidrBlockStateCodeAssociated,
+		svcsdktypes.VpcCidrBlockStateCodeAssociating,
+	)
+}
+
+// ipv6CIDRBlockRequestMatches returns true if the IPv6 CIDR block association
+// can be the result of the request. Requests for a specific CIDR block are
+// matched by CIDR block, other requests by the pool the block comes from.
+func ipv6CIDRBlockRequestMatches(
+	req *svcapitypes.AssociateVPCCIDRBlockInput,
+	assoc *svcapitypes.VPCIPv6CIDRBlockAssociation,
+) bool {
+	if req.IPv6CIDRBlock != nil {
+		return assoc.IPv6CIDRBlock != nil && SameCIDRBlock(*req.IPv6CIDRBlock, *assoc.IPv6CIDRBlock)
+	}
+	pool := ""
+	if assoc.IPv6Pool != nil {
+		pool = *assoc.IPv6Pool
+	}
+	switch {
+	case req.AmazonProvidedIPv6CIDRBlock != nil && *req.AmazonProvidedIPv6CIDRBlock:
+		if req.IPv6CIDRBlockNetworkBorderGroup != nil &&
+			(assoc.NetworkBorderGroup == nil || *assoc.NetworkBorderGroup != *req.IPv6CIDRBlockNetworkBorderGroup) {
+			return false
+		}
+		return pool == amazonIPv6Pool
+	case req.IPv6Pool != nil:
+		return pool == *req.IPv6Pool
+	case req.IPv6IPAMPoolID != nil:
+		// The IPAM pool a block was allocated from is not reported, so any
+		// block that isn't Amazon-provided may match.
+		if pool == amazonIPv6Pool {
+			return false
+		}
+		if req.IPv6NetmaskLength != nil && assoc.IPv6CIDRBlock != nil {
+			if p, err := netip.ParsePrefix(*assoc.IPv6CIDRBlock); err == nil {
+				return int64(p.Bits()) == *req.IPv6NetmaskLength
 			}
-			if res.CidrBlockAssociation.CidrBlock != nil {
-				cidrblockassociation.CIDRBlock = res.CidrBlockAssociation.CidrBlock
+		}
+		return true
+	}
+	return false
+}
+
+// getIPv6CIDRBlocksDifference returns the IPv6 CIDR block requests that no
+// active or failed association matches, and the active associations that
+// match no request.
+func getIPv6CIDRBlocksDifference(
+	desired []*svcapitypes.AssociateVPCCIDRBlockInput,
+	latest []*svcapitypes.VPCIPv6CIDRBlockAssociation,
+) (toAdd []*svcapitypes.AssociateVPCCIDRBlockInput, toRemove []*svcapitypes.VPCIPv6CIDRBlockAssociation) {
+	toAdd, toRemove, _ = matchIPv6CIDRBlocks(desired, latest)
+	return toAdd, toRemove
+}
+
+// matchIPv6CIDRBlocks matches IPv6 CIDR block requests with the latest
+// associations. Requests for a specific CIDR block are matched first, then
+// requests for a specific pool and last requests for an IPAM pool, so a
+// looser request never takes the association of a stricter one. Requests
+// are matched with active associations before failed ones; a request
+// matching a failed association is returned in failed rather than toAdd so
+// it is not retried on every reconcile.
+func matchIPv6CIDRBlocks(
+	desired []*svcapitypes.AssociateVPCCIDRBlockInput,
+	latest []*svcapitypes.VPCIPv6CIDRBlockAssociation,
+) (
+	toAdd []*svcapitypes.AssociateVPCCIDRBlockInput,
+	toRemove []*svcapitypes.VPCIPv6CIDRBlockAssociation,
+	failed []*svcapitypes.VPCIPv6CIDRBlockAssociation,
+) {
+	active := []*svcapitypes.VPCIPv6CIDRBlockAssociation{}
+	failing := []*svcapitypes.VPCIPv6CIDRBlockAssociation{}
+	for _, assoc := range latest {
+		switch {
+		case isIPv6CIDRBlockActive(assoc):
+			active = append(active, assoc)
+		case isCIDRBlockFailed(assoc.IPv6CIDRBlockState):
+			failing = append(failing, assoc)
+		}
+	}
+	matched := make([]bool, len(active))
+	matchedFailing := make([]bool, len(failing))
+
+	specificity := func(req *svcapitypes.AssociateVPCCIDRBlockInput) int {
+		switch {
+		case req.IPv6CIDRBlock != nil:
+			return 0
+		case req.IPv6IPAMPoolID != nil:
+			return 2
+		}
+		return 1
+	}
+	for pass := 0; pass < 3; pass++ {
+		for _, req := range desired {
+			if specificity(req) != pass {
+				continue
 			}
-			if res.CidrBlockAssociation.CidrBlockState != nil {
-				cidrblockstate := &svcapitypes.VPCCIDRBlockState{}
-				if res.CidrBlockAssociation.CidrBlockState.State != "" {
-					cidrblockstate.State = aws.String(string(res.CidrBlockAssociation.CidrBlockState.State))
+			found := false
+			for i, assoc := range active {
+				if !matched[i] && ipv6CIDRBlockRequestMatches(req, assoc) {
+					matched[i] = true
+					found = true
+					break
 				}
-				if res.CidrBlockAssociation.CidrBlockState.StatusMessage != nil {
-					cidrblockstate.StatusMessage = res.CidrBlockAssociation.CidrBlockState.StatusMessage
+			}
+			for i, assoc := range failing {
+				if found {
+					break
 				}
+				if !matchedFailing[i] && ipv6CIDRBlockRequestMatches(req, assoc) {
+					matchedFailing[i] = true
+					failed = append(failed, assoc)
+					found = true
+				}
+			}
+			if !found {
+				toAdd = append(toAdd, req)
 			}
-			cidrblockassociationset = append(cidrblockassociationset, cidrblockassociation)
 		}
 	}
-	if cidrblockassociationset != nil {
-		if toDeleteCIDRs != nil {
-			latest.ko.Status.CIDRBlockAssociationSet = cidrblockassociationset
-		} else {
-			latest.ko.Status.CIDRBlockAssociationSet = append(latest.ko.Status.CIDRBlockAssociationSet, cidrblockassociationset...)
+	for i, assoc := range active {
+		if !matched[i] {
+			toRemove = append(toRemove, assoc)
+		}
+	}
+	return toAdd, toRemove, failed
+}
+
+// getFailedCIDRBlocks returns a description of every desired IPv4 and IPv6
+// CIDR block whose association with the VPC failed.
+func getFailedCIDRBlocks(
+	desired *resource,
+	latest *svcapitypes.VPC,
+) []string {
+	failed := []string{}
+	for _, cidrAssoc := range latest.Status.CIDRBlockAssociationSet {
+		if cidrAssoc.CIDRBlock == nil || !isCIDRBlockFailed(cidrAssoc.CIDRBlockState) {
+			continue
+		}
+		if !hasActiveCIDRBlock(latest, *cidrAssoc.CIDRBlock) && containsCIDRBlock(desired.ko.Spec.CIDRBlocks, *cidrAssoc.CIDRBlock) {
+			failed = append(failed, failedCIDRBlockMessage(cidrAssoc.CIDRBlock, cidrAssoc.CIDRBlockState))
+		}
+	}
+	if desired.ko.Spec.IPv6CIDRBlocks != nil {
+		_, _, failedIPv6 := matchIPv6CIDRBlocks(
+			desired.ko.Spec.IPv6CIDRBlocks, latest.Status.IPv6CIDRBlockAssociationSet,
+		)
+		for _, cidrAssoc := range failedIPv6 {
+			failed = append(failed, failedCIDRBlockMessage(cidrAssoc.IPv6CIDRBlock, cidrAssoc.IPv6CIDRBlockState))
+		}
+	}
+	return failed
+}
+
+// hasActiveCIDRBlock returns true if the IPv4 CIDR block is associated or
+// being associated with the VPC.
+func hasActiveCIDRBlock(ko *svcapitypes.VPC, cidrBlock string) bool {
+	for _, cidrAssoc := range ko.Status.CIDRBlockAssociationSet {
+		if cidrAssoc.CIDRBlock != nil && *cidrAssoc.CIDRBlock == cidrBlock &&
+			cidrBlockStateIs(cidrAssoc.CIDRBlockState,
+				svcsdktypes.VpcCidrBlockStateCodeAssociated,
+				svcsdktypes.VpcCidrBlockStateCodeAssociating,
+			) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsCIDRBlock returns true if cidrBlocks contains cidrBlock
+func containsCIDRBlock(cidrBlocks []*string, cidrBlock string) bool {
+	for _, c := range cidrBlocks {
+		if c != nil && *c == cidrBlock {
+			return true
 		}
+	}
+	return false
+}
+
+// SameCIDRBlock returns true if both strings are the same CIDR block, once
+// host bits are masked. Subnet resources use it to compare the IPv6 CIDR block
+// they request to the one EC2 associates.
+func SameCIDRBlock(a, b string) bool {
+	pa, err := netip.ParsePrefix(a)
+	if err != nil {
+		return a == b
+	}
+	pb, err := netip.ParsePrefix(b)
+	if err != nil {
+		return a == b
+	}
+	return pa.Masked() == pb.Masked()
+}
+
+// syncIPv6CIDRBlocks analyzes desired IPv6 CIDR block requests and the
+// latest IPv6 CIDR block associations and executes API calls to
+// Associate/Disassociate CIDRs as needed
+func (rm *resourceManager) syncIPv6CIDRBlocks(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncIPv6CIDRBlocks")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	toAdd, toRemove := getIPv6CIDRBlocksDifference(
+		desired.ko.Spec.IPv6CIDRBlocks, latest.ko.Status.IPv6CIDRBlockAssociationSet,
+	)
 
+	for _, assoc := range toRemove {
+		input := &svcsdk.DisassociateVpcCidrBlockInput{
+			AssociationId: assoc.AssociationID,
+		}
+		_, err = rm.sdkapi.DisassociateVpcCidrBlock(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "DisassociateVpcCidrBlock", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, req := range toAdd {
+		input, err := newAssociateIPv6CIDRBlockPayload(*latest.ko.Status.VPCID, req)
+		if err != nil {
+			return err
+		}
+		_, err = rm.sdkapi.AssociateVpcCidrBlock(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "AssociateVpcCidrBlock", err)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// newAssociateIPv6CIDRBlockPayload returns the AssociateVpcCidrBlock request
+// for an IPv6 CIDR block request
+func newAssociateIPv6CIDRBlockPayload(
+	vpcID string,
+	req *svcapitypes.AssociateVPCCIDRBlockInput,
+) (*svcsdk.AssociateVpcCidrBlockInput, error) {
+	res := &svcsdk.AssociateVpcCidrBlockInput{
+		VpcId:                           aws.String(vpcID),
+		AmazonProvidedIpv6CidrBlock:     req.AmazonProvidedIPv6CIDRBlock,
+		Ipv6CidrBlock:                   req.IPv6CIDRBlock,
+		Ipv6CidrBlockNetworkBorderGroup: req.IPv6CIDRBlockNetworkBorderGroup,
+		Ipv6IpamPoolId:                  req.IPv6IPAMPoolID,
+		Ipv6Pool:                        req.IPv6Pool,
+	}
+	if req.IPv6NetmaskLength != nil {
+		if *req.IPv6NetmaskLength > math.MaxInt32 || *req.IPv6NetmaskLength < math.MinInt32 {
+			return nil, fmt.Errorf("error: field Ipv6NetmaskLength is of type int32")
+		}
+		res.Ipv6NetmaskLength = aws.Int32(int32(*req.IPv6NetmaskLength))
+	}
+	return res, nil
+}
+
 // setSpecCIDRs sets Spec.CIDRBlocks using the CIDRs in
-// Status.CIDRBlockAssociationSet, which is set via sdkCreate/sdkFind
+// Status.CIDRBlockAssociationSet, which is set via sdkCreate/sdkFind.
+// Blocks that are disassociated, or being disassociated, are left out.
+// Failed blocks are kept only while desired, so a failed association is
+// reported instead of being retried on every reconcile.
 func (rm *resourceManager) setSpecCIDRs(
+	desired *resource,
 	ko *svcapitypes.VPC,
 ) {
 	ko.Spec.CIDRBlocks = nil
-	if ko.Status.CIDRBlockAssociationSet != nil {
-		for _, cidrAssoc := range ko.Status.CIDRBlockAssociationSet {
-			ko.Spec.CIDRBlocks = append(ko.Spec.CIDRBlocks, cidrAssoc.CIDRBlock)
+	for _, cidrAssoc := range ko.Status.CIDRBlockAssociationSet {
+		if cidrAssoc.CIDRBlock == nil || containsCIDRBlock(ko.Spec.CIDRBlocks, *cidrAssoc.CIDRBlock) {
+			continue
 		}
+		keep := cidrAssoc.CIDRBlockState == nil ||
+			hasActiveCIDRBlock(ko, *cidrAssoc.CIDRBlock) ||
+			(isCIDRBlockFailed(cidrAssoc.CIDRBlockState) &&
+				containsCIDRBlock(desired.ko.Spec.CIDRBlocks, *cidrAssoc.CIDRBlock))
+		if !keep {
+			continue
+		}
+		ko.Spec.CIDRBlocks = append(ko.Spec.CIDRBlocks, cidrAssoc.CIDRBlock)
 	}
 }
 
@@ -345,10 +679,25 @@ func (rm *resourceManager) customUpdateVPC(
 		if err := rm.syncCIDRBlocks(ctx, desired, latest); err != nil {
 			return nil, err
 		}
+		// Associations are asynchronous; requeue until every CIDR block
+		// has settled.
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, nil, nil)
 	}
 	updated.ko.Status.CIDRBlockAssociationSet = latest.ko.Status.CIDRBlockAssociationSet
 	updated.ko.Status.IPv6CIDRBlockAssociationSet = latest.ko.Status.IPv6CIDRBlockAssociationSet
 
+	if delta.DifferentAt("Spec.IPv6CIDRBlocks") {
+		if areCIDRBlocksSyncing(latest) {
+			return nil, requeueWaitWhileCIDRBlocksSyncing
+		}
+		if err := rm.syncIPv6CIDRBlocks(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		// Associations are asynchronous; requeue until every IPv6 CIDR
+		// block has settled.
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, nil, nil)
+	}
+
 	if delta.DifferentAt("Spec.DisallowSecurityGroupDefaultRules") {
 		if desired.ko.Spec.DisallowSecurityGroupDefaultRules != nil && *desired.ko.Spec.DisallowSecurityGroupDefaultRules {
 			if err = rm.deleteSecurityGroupDefaultRules(ctx, desired); err != nil {
@@ -651,6 +1000,15 @@ func customPreCompare(
 	if a.ko.Spec.DisallowSecurityGroupDefaultRules == nil {
 		a.ko.Spec.DisallowSecurityGroupDefaultRules = ptr(false)
 	}
+	// IPv6 CIDR blocks are only managed when the list is set.
+	if a.ko.Spec.IPv6CIDRBlocks != nil {
+		toAdd, toRemove := getIPv6CIDRBlocksDifference(
+			a.ko.Spec.IPv6CIDRBlocks, b.ko.Status.IPv6CIDRBlockAssociationSet,
+		)
+		if len(toAdd) > 0 || len(toRemove) > 0 {
+			delta.Add("Spec.IPv6CIDRBlocks", a.ko.Spec.IPv6CIDRBlocks, b.ko.Spec.IPv6CIDRBlocks)
+		}
+	}
 }
 
 var (
@@ -663,20 +1021,50 @@ var (
 	)
 )
 
-// areCIDRBlocksSyncing returns true if any CIDR blocks are in transitional
-// states (associating, disassociating).
+// areCIDRBlocksSyncing returns true if any IPv4 or IPv6 CIDR blocks are in
+// transitional states (associating, disassociating).
 func areCIDRBlocksSyncing(r *resource) bool {
-	if r.ko.Status.CIDRBlockAssociationSet == nil {
-		return false
-	}
 	for _, cidrAssoc := range r.ko.Status.CIDRBlockAssociationSet {
-		if cidrAssoc.CIDRBlockState == nil || cidrAssoc.CIDRBlockState.State == nil {
-			continue
+		if cidrBlockStateIs(cidrAssoc.CIDRBlockState,
+			svcsdktypes.VpcCidrBlockStateCodeAssociating,
+			svcsdktypes.VpcCidrBlockStateCodeDisassociating,
+		) {
+			return true
 		}
-		state := *cidrAssoc.CIDRBlockState.State
-		if state == "associating" || state == "disassociating" {
+	}
+	for _, cidrAssoc := range r.ko.Status.IPv6CIDRBlockAssociationSet {
+		if cidrBlockStateIs(cidrAssoc.IPv6CIDRBlockState,
+			svcsdktypes.VpcCidrBlockStateCodeAssociating,
+			svcsdktypes.VpcCidrBlockStateCodeDisassociating,
+		) {
 			return true
 		}
 	}
 	return false
 }
+
+// setCIDRBlocksFailedCondition sets an advisory condition listing the
+// desired CIDR blocks that failed to associate with the VPC, and keeps the
+// resource from being marked synced until they are associated or removed.
+// The condition is removed once no desired CIDR block has failed.
+func setCIDRBlocksFailedCondition(desired *resource, ko *svcapitypes.VPC) {
+	conditions := []*ackv1alpha1.Condition{}
+	for _, condition := range ko.Status.Conditions {
+		if condition.Type != ackv1alpha1.ConditionTypeAdvisory ||
+			aws.ToString(condition.Reason) != reasonCIDRBlockAssociationFailed {
+			conditions = append(conditions, condition)
+		}
+	}
+	ko.Status.Conditions = conditions
+
+	failed := getFailedCIDRBlocks(desired, ko)
+	if len(failed) == 0 {
+		return
+	}
+	msg := fmt.Sprintf(
+		"CIDR block association failed, remove or replace the CIDR block to retry: %s",
+		strings.Join(failed, "; "),
+	)
+	ackcondition.SetAdvisory(&resource{ko}, corev1.ConditionTrue, &msg, aws.String(reasonCIDRBlockAssociationFailed))
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"testing"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 )
@@ -44,3 +47,270 @@ func TestCustomUpdateVPCPropagatesCIDRBlockStatusChanges(t *testing.T) {
 	assert.Equal(t, latest.ko.Status.CIDRBlockAssociationSet, updated.ko.Status.CIDRBlockAssociationSet)
 	assert.Equal(t, latest.ko.Status.IPv6CIDRBlockAssociationSet, updated.ko.Status.IPv6CIDRBlockAssociationSet)
 }
+
+func ipv6Association(cidrBlock, pool, state string) *svcapitypes.VPCIPv6CIDRBlockAssociation {
+	return &svcapitypes.VPCIPv6CIDRBlockAssociation{
+		AssociationID: aws.String("vpc-cidr-assoc-" + cidrBlock),
+		IPv6CIDRBlock: aws.String(cidrBlock),
+		IPv6Pool:      aws.String(pool),
+		IPv6CIDRBlockState: &svcapitypes.VPCCIDRBlockState{
+			State: aws.String(state),
+		},
+	}
+}
+
+func TestGetIPv6CIDRBlocksDifference(t *testing.T) {
+	amazon := &svcapitypes.AssociateVPCCIDRBlockInput{AmazonProvidedIPv6CIDRBlock: aws.Bool(true)}
+	byoip := &svcapitypes.AssociateVPCCIDRBlockInput{
+		IPv6CIDRBlock: aws.String("2001:db8:1000::/56"),
+		IPv6Pool:      aws.String("ipv6pool-ec2-1"),
+	}
+	ipam := &svcapitypes.AssociateVPCCIDRBlockInput{
+		IPv6IPAMPoolID:    aws.String("ipam-pool-1"),
+		IPv6NetmaskLength: aws.Int64(56),
+	}
+
+	amazonAssoc := ipv6Association("2600:1f18::/56", "Amazon", "associated")
+	byoipAssoc := ipv6Association("2001:db8:1000::/56", "ipv6pool-ec2-1", "associated")
+	ipamAssoc := ipv6Association("2001:db8:2000::/56", "IPAM Managed", "associated")
+
+	tt := []struct {
+		id       string
+		desired  []*svcapitypes.AssociateVPCCIDRBlockInput
+		latest   []*svcapitypes.VPCIPv6CIDRBlockAssociation
+		toAdd    []*svcapitypes.AssociateVPCCIDRBlockInput
+		toRemove []*svcapitypes.VPCIPv6CIDRBlockAssociation
+	}{
+		{"in sync",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon, byoip, ipam},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipamAssoc, byoipAssoc, amazonAssoc},
+			nil, nil,
+		},
+		{"add Amazon-provided block",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon},
+			nil,
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon}, nil,
+		},
+		{"associating block is not added again",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipv6Association("2600:1f18::/56", "Amazon", "associating")},
+			nil, nil,
+		},
+		{"disassociated block is added again",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipv6Association("2600:1f18::/56", "Amazon", "disassociated")},
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon}, nil,
+		},
+		{"failed block is not added again",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipv6Association("2600:1f18::/56", "Amazon", "failed")},
+			nil, nil,
+		},
+		{"failed block that is not desired is not removed",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipv6Association("2600:1f18::/56", "Amazon", "failed")},
+			nil, nil,
+		},
+		{"remove block",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{amazon},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{amazonAssoc, byoipAssoc},
+			nil, []*svcapitypes.VPCIPv6CIDRBlockAssociation{byoipAssoc},
+		},
+		{"remove all blocks",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{amazonAssoc},
+			nil, []*svcapitypes.VPCIPv6CIDRBlockAssociation{amazonAssoc},
+		},
+		{"IPAM request does not take the BYOIP block",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{ipam, byoip},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{byoipAssoc},
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{ipam}, nil,
+		},
+		{"IPAM request with a different netmask length",
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{{
+				IPv6IPAMPoolID:    aws.String("ipam-pool-1"),
+				IPv6NetmaskLength: aws.Int64(52),
+			}},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipamAssoc},
+			[]*svcapitypes.AssociateVPCCIDRBlockInput{{
+				IPv6IPAMPoolID:    aws.String("ipam-pool-1"),
+				IPv6NetmaskLength: aws.Int64(52),
+			}},
+			[]*svcapitypes.VPCIPv6CIDRBlockAssociation{ipamAssoc},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			toAdd, toRemove := getIPv6CIDRBlocksDifference(tc.desired, tc.latest)
+			assert.Equal(t, tc.toAdd, toAdd)
+			assert.Equal(t, tc.toRemove, toRemove)
+
+			a := &resource{ko: &svcapitypes.VPC{Spec: svcapitypes.VPCSpec{IPv6CIDRBlocks: tc.desired}}}
+			b := &resource{ko: &svcapitypes.VPC{Status: svcapitypes.VPCStatus{IPv6CIDRBlockAssociationSet: tc.latest}}}
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, a, b)
+			assert.Equal(t, len(tc.toAdd) > 0 || len(tc.toRemove) > 0, delta.DifferentAt("Spec.IPv6CIDRBlocks"))
+		})
+	}
+}
+
+func TestUnmanagedIPv6CIDRBlocks(t *testing.T) {
+	a := &resource{ko: &svcapitypes.VPC{}}
+	b := &resource{ko: &svcapitypes.VPC{Status: svcapitypes.VPCStatus{
+		IPv6CIDRBlockAssociationSet: []*svcapitypes.VPCIPv6CIDRBlockAssociation{
+			ipv6Association("2600:1f18::/56", "Amazon", "associated"),
+		},
+	}}}
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, a, b)
+	assert.False(t, delta.DifferentAt("Spec.IPv6CIDRBlocks"))
+}
+
+func cidrAssociation(cidrBlock, state, message string) *svcapitypes.VPCCIDRBlockAssociation {
+	assoc := &svcapitypes.VPCCIDRBlockAssociation{
+		AssociationID: aws.String("vpc-cidr-assoc-" + cidrBlock),
+		CIDRBlock:     aws.String(cidrBlock),
+		CIDRBlockState: &svcapitypes.VPCCIDRBlockState{
+			State: aws.String(state),
+		},
+	}
+	if message != "" {
+		assoc.CIDRBlockState.StatusMessage = aws.String(message)
+	}
+	return assoc
+}
+
+func TestSetSpecCIDRs(t *testing.T) {
+	tt := []struct {
+		id      string
+		desired []*string
+		latest  []*svcapitypes.VPCCIDRBlockAssociation
+		want    []*string
+	}{
+		{"associated and associating blocks",
+			[]*string{aws.String("10.0.0.0/16")},
+			[]*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "associating", ""),
+			},
+			[]*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+		},
+		{"disassociated blocks are left out",
+			[]*string{aws.String("10.0.0.0/16")},
+			[]*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "disassociating", ""),
+				cidrAssociation("10.2.0.0/16", "disassociated", ""),
+			},
+			[]*string{aws.String("10.0.0.0/16")},
+		},
+		{"failed block is kept while desired",
+			[]*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+			[]*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "failed", "overlaps"),
+			},
+			[]*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+		},
+		{"failed block is left out once removed",
+			[]*string{aws.String("10.0.0.0/16")},
+			[]*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "failed", "overlaps"),
+			},
+			[]*string{aws.String("10.0.0.0/16")},
+		},
+		{"block associated again after disassociation",
+			[]*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+			[]*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "disassociated", ""),
+				cidrAssociation("10.1.0.0/16", "associating", ""),
+			},
+			[]*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+		},
+	}
+
+	rm := &resourceManager{}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			desired := &resource{ko: &svcapitypes.VPC{Spec: svcapitypes.VPCSpec{CIDRBlocks: tc.desired}}}
+			ko := &svcapitypes.VPC{Status: svcapitypes.VPCStatus{CIDRBlockAssociationSet: tc.latest}}
+			rm.setSpecCIDRs(desired, ko)
+			assert.Equal(t, tc.want, ko.Spec.CIDRBlocks)
+		})
+	}
+}
+
+func TestSetCIDRBlocksFailedCondition(t *testing.T) {
+	desired := &resource{ko: &svcapitypes.VPC{Spec: svcapitypes.VPCSpec{
+		CIDRBlocks: []*string{aws.String("10.0.0.0/16"), aws.String("10.1.0.0/16")},
+		IPv6CIDRBlocks: []*svcapitypes.AssociateVPCCIDRBlockInput{
+			{AmazonProvidedIPv6CIDRBlock: aws.Bool(true)},
+		},
+	}}}
+
+	t.Run("all blocks associated", func(t *testing.T) {
+		ko := &svcapitypes.VPC{Status: svcapitypes.VPCStatus{
+			CIDRBlockAssociationSet: []*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "associated", ""),
+				cidrAssociation("10.2.0.0/16", "failed", "not desired"),
+			},
+			IPv6CIDRBlockAssociationSet: []*svcapitypes.VPCIPv6CIDRBlockAssociation{
+				ipv6Association("2600:1f18::/56", "Amazon", "associated"),
+			},
+		}}
+		setCIDRBlocksFailedCondition(desired, ko)
+		assert.Empty(t, ko.Status.Conditions)
+	})
+
+	t.Run("failed blocks", func(t *testing.T) {
+		failedIPv6 := ipv6Association("2600:1f18::/56", "Amazon", "failed")
+		failedIPv6.IPv6CIDRBlockState.StatusMessage = aws.String("pool exhausted")
+		ko := &svcapitypes.VPC{Status: svcapitypes.VPCStatus{
+			CIDRBlockAssociationSet: []*svcapitypes.VPCCIDRBlockAssociation{
+				cidrAssociation("10.0.0.0/16", "associated", ""),
+				cidrAssociation("10.1.0.0/16", "failed", "overlaps with a peered VPC"),
+			},
+			IPv6CIDRBlockAssociationSet: []*svcapitypes.VPCIPv6CIDRBlockAssociation{failedIPv6},
+		}}
+		setCIDRBlocksFailedCondition(desired, ko)
+
+		r := &resource{ko}
+		synced := ackcondition.Synced(r)
+		require.NotNil(t, synced)
+		assert.Equal(t, corev1.ConditionFalse, synced.Status)
+		advisory := ackcondition.AdvisoryWithReason(r, reasonCIDRBlockAssociationFailed)
+		require.NotNil(t, advisory)
+		assert.Equal(t, ackv1alpha1.ConditionTypeAdvisory, advisory.Type)
+		assert.Contains(t, *advisory.Message, "10.1.0.0/16: failed (overlaps with a peered VPC)")
+		assert.Contains(t, *advisory.Message, "2600:1f18::/56: failed (pool exhausted)")
+
+		// The advisory is dropped once the failed blocks are replaced
+		ko.Status.CIDRBlockAssociationSet[1] = cidrAssociation("10.1.0.0/16", "associated", "")
+		ko.Status.IPv6CIDRBlockAssociationSet[0] = ipv6Association("2600:1f18::/56", "Amazon", "associated")
+		setCIDRBlocksFailedCondition(desired, ko)
+		assert.Nil(t, ackcondition.AdvisoryWithReason(r, reasonCIDRBlockAssociationFailed))
+	})
+}
+
+func TestSameCIDRBlock(t *testing.T) {
+	tt := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"2600:1f14::/56", "2600:1f14::/56", true},
+		{"2600:1f14:0:1::/56", "2600:1f14::/56", true},
+		{"2600:1f14::/56", "2600:1f14::/64", false},
+		{"10.0.0.1/16", "10.0.0.0/16", true},
+		{"invalid", "invalid", true},
+		{"invalid", "10.0.0.0/16", false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SameCIDRBlock(tc.a, tc.b))
+		})
+	}
+}
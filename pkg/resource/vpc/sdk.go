@@ -205,7 +205,7 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
-	rm.setSpecCIDRs(ko)
+	rm.setSpecCIDRs(r, ko)
 	if dnsAttrs, err := rm.getDNSAttributes(ctx, *ko.Status.VPCID); err != nil {
 		return nil, err
 	} else {
@@ -232,6 +232,11 @@ func (rm *resourceManager) sdkFind(
 		return &resource{ko}, requeueWaitWhileCIDRBlocksSyncing
 	}
 
+	// Desired CIDR blocks that failed to associate are reported in an
+	// advisory condition, and ACK.ResourceSynced stays False until every
+	// desired CIDR block is associated.
+	setCIDRBlocksFailedCondition(r, ko)
+
 	return &resource{ko}, nil
 }
 
@@ -403,9 +408,19 @@ func (rm *resourceManager) sdkCreate(
 		ko.Spec.CIDRBlocks = make([]*string, 1)
 		ko.Spec.CIDRBlocks[0] = resp.Vpc.CidrBlock
 	}
-	rm.syncCIDRBlocks(ctx, desired, &resource{ko})
+	// When the primary CIDR is allocated from an IPAM pool, there are no
+	// secondary CIDRs to associate and the allocated CIDR is kept in Spec.
+	if len(desired.ko.Spec.CIDRBlocks) > 0 {
+		if err := rm.syncCIDRBlocks(ctx, desired, &resource{ko}); err != nil {
+			// Keep the desired CIDR blocks in Spec so the update path
+			// associates the remaining ones.
+			ko.Spec.CIDRBlocks = desired.ko.Spec.CIDRBlocks
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to associate CIDR blocks"), nil)
+			return &resource{ko}, ackrequeue.NeededAfter(err, 5*time.Second)
+		}
+	}
 
-	rm.setSpecCIDRs(ko)
+	rm.setSpecCIDRs(desired, ko)
 	err = rm.createAttributes(ctx, &resource{ko})
 	if err != nil {
 		return nil, err
@@ -426,6 +441,24 @@ func (rm *resourceManager) sdkCreate(
 		return &resource{ko}, err
 	}
 
+	// Additional IPv6 CIDR blocks are associated by the update path, which
+	// also waits for the associations to settle.
+	if desired.ko.Spec.IPv6CIDRBlocks != nil {
+		toAdd, toRemove := getIPv6CIDRBlocksDifference(desired.ko.Spec.IPv6CIDRBlocks, ko.Status.IPv6CIDRBlockAssociationSet)
+		if len(toAdd) > 0 || len(toRemove) > 0 {
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to associate IPv6 CIDR blocks"), nil)
+			err = ackrequeue.NeededAfter(fmt.Errorf("VPC created but IPv6 CIDR blocks need to be associated"), time.Second)
+			return &resource{ko}, err
+		}
+	}
+
+	// Secondary IPv4 CIDR blocks associate asynchronously; requeue until
+	// they have settled.
+	if areCIDRBlocksSyncing(&resource{ko}) {
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to wait for CIDR block associations"), nil)
+		return &resource{ko}, requeueWaitWhileCIDRBlocksSyncing
+	}
+
 	return &resource{ko}, nil
 }
 
@@ -0,0 +1,229 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vpn_connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrVPNConnectionPending = fmt.Errorf(
+		"VPNConnection in '%v' state, waiting for it to become available",
+		svcsdktypes.VpnStatePending,
+	)
+)
+
+var (
+	requeueWaitWhilePending = ackrequeue.NeededAfter(
+		ErrVPNConnectionPending,
+		30*time.Second,
+	)
+)
+
+// checkForMissingRequiredFields returns true if the VPNConnectionID used to
+// describe the resource has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.VPNConnectionID == nil
+}
+
+func isVPNConnectionPending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	return *r.ko.Status.State == string(svcsdktypes.VpnStatePending)
+}
+
+// isVPNConnectionDeleted returns true if the VPN connection has been deleted.
+// Deleted connections stay visible in DescribeVpnConnections for a while
+// after deletion.
+func isVPNConnectionDeleted(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	return *r.ko.Status.State == string(svcsdktypes.VpnStateDeleted)
+}
+
+// setTunnelOptions sets Status.TunnelOptions from the tunnel options returned
+// by the EC2 API. The pre-shared keys are deliberately left out so they never
+// end up in the resource status.
+func setTunnelOptions(
+	ko *svcapitypes.VPNConnection,
+	opts *svcsdktypes.VpnConnectionOptions,
+) {
+	if opts == nil || opts.TunnelOptions == nil {
+		ko.Status.TunnelOptions = nil
+		return
+	}
+	tunnelOptions := make([]*svcapitypes.TunnelOption, 0, len(opts.TunnelOptions))
+	for _, t := range opts.TunnelOptions {
+		tunnelOptions = append(tunnelOptions, &svcapitypes.TunnelOption{
+			DPDTimeoutAction:             t.DpdTimeoutAction,
+			DPDTimeoutSeconds:            int64OrNil(t.DpdTimeoutSeconds),
+			EnableTunnelLifecycleControl: t.EnableTunnelLifecycleControl,
+			OutsideIPAddress:             t.OutsideIpAddress,
+			Phase1LifetimeSeconds:        int64OrNil(t.Phase1LifetimeSeconds),
+			Phase2LifetimeSeconds:        int64OrNil(t.Phase2LifetimeSeconds),
+			RekeyFuzzPercentage:          int64OrNil(t.RekeyFuzzPercentage),
+			RekeyMarginTimeSeconds:       int64OrNil(t.RekeyMarginTimeSeconds),
+			ReplayWindowSize:             int64OrNil(t.ReplayWindowSize),
+			StartupAction:                t.StartupAction,
+			TunnelInsideCIDR:             t.TunnelInsideCidr,
+			TunnelInsideIPv6CIDR:         t.TunnelInsideIpv6Cidr,
+		})
+	}
+	ko.Status.TunnelOptions = tunnelOptions
+}
+
+func int64OrNil(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
+
+// preSharedKeySecretKey returns the key the pre-shared key of the tunnel at
+// index i is stored under in the Secret referenced by
+// Spec.PreSharedKeySecretRef.
+func preSharedKeySecretKey(key string, i int) string {
+	return fmt.Sprintf("%s-tunnel%d", key, i+1)
+}
+
+// syncPreSharedKeys writes the pre-shared keys of the VPN tunnels to the
+// Secret referenced by Spec.PreSharedKeySecretRef. The Secret is only patched
+// when a stored key differs from the one returned by the EC2 API. Pre-shared
+// keys kept in AWS Secrets Manager are not returned by DescribeVpnConnections
+// and are therefore never written.
+func (rm *resourceManager) syncPreSharedKeys(
+	ctx context.Context,
+	r *resource,
+	opts *svcsdktypes.VpnConnectionOptions,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncPreSharedKeys")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	ref := r.ko.Spec.PreSharedKeySecretRef
+	if ref == nil || opts == nil || !r.ko.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	// The Secret is read and written in the same namespace, resolved once.
+	// A Secret in another namespace is only written to when the controller
+	// allows cross-namespace references.
+	namespace, _, err := ackrt.ValidateCrossNamespaceReferenceString(
+		rm.cfg.EnableCrossNamespace, r.ko.Namespace, ref.Namespace, ref.Name,
+	)
+	if err != nil {
+		return ackerr.NewTerminalError(err)
+	}
+
+	for i, t := range opts.TunnelOptions {
+		if t.PreSharedKey == nil {
+			continue
+		}
+		key := preSharedKeySecretKey(ref.Key, i)
+		current, err := rm.rr.SecretValueFromReference(ctx, &ackv1alpha1.SecretKeyReference{
+			SecretReference: corev1.SecretReference{
+				Name:      ref.Name,
+				Namespace: namespace,
+			},
+			Key: key,
+		})
+		if err != nil && !errors.Is(err, ackerr.SecretNotFound) {
+			return err
+		}
+		if current == *t.PreSharedKey {
+			continue
+		}
+		rlog.Debug("writing tunnel pre-shared key to secret", "secret", ref.Name, "key", key)
+		if err = rm.rr.WriteToSecret(ctx, *t.PreSharedKey, namespace, ref.Name, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) customUpdateVPNConnection(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateVPNConnection")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.VPNConnectionID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateVpnConnectionInput.TagSpecification
+// and ensures the ResourceType is always set to 'vpn-connection'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateVpnConnectionInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "vpn-connection"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
@@ -0,0 +1,186 @@
+package vpn_connection
+
+import (
+	"context"
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPreSharedKeySecretKey(t *testing.T) {
+	tt := []struct {
+		key      string
+		index    int
+		expected string
+	}{
+		{"psk", 0, "psk-tunnel1"},
+		{"psk", 1, "psk-tunnel2"},
+		{"vpn.key", 1, "vpn.key-tunnel2"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, preSharedKeySecretKey(tc.key, tc.index))
+		})
+	}
+}
+
+func TestSetTunnelOptions(t *testing.T) {
+	newOptions := func(psk string) *svcsdktypes.VpnConnectionOptions {
+		return &svcsdktypes.VpnConnectionOptions{
+			TunnelOptions: []svcsdktypes.TunnelOption{
+				{
+					OutsideIpAddress:  aws.String("203.0.113.10"),
+					PreSharedKey:      aws.String(psk),
+					DpdTimeoutSeconds: aws.Int32(30),
+					TunnelInsideCidr:  aws.String("169.254.10.0/30"),
+				},
+			},
+		}
+	}
+
+	t.Run("pre-shared keys are left out", func(t *testing.T) {
+		a := &svcapitypes.VPNConnection{}
+		b := &svcapitypes.VPNConnection{}
+		setTunnelOptions(a, newOptions("first"))
+		setTunnelOptions(b, newOptions("rotated"))
+
+		assert.Equal(t, a.Status.TunnelOptions, b.Status.TunnelOptions)
+		assert.Equal(t, []*svcapitypes.TunnelOption{{
+			DPDTimeoutSeconds: aws.Int64(30),
+			OutsideIPAddress:  aws.String("203.0.113.10"),
+			TunnelInsideCIDR:  aws.String("169.254.10.0/30"),
+		}}, a.Status.TunnelOptions)
+	})
+
+	t.Run("missing options clear the status", func(t *testing.T) {
+		ko := &svcapitypes.VPNConnection{}
+		setTunnelOptions(ko, newOptions("psk"))
+		setTunnelOptions(ko, nil)
+		assert.Nil(t, ko.Status.TunnelOptions)
+	})
+}
+
+// fakeSecretReconciler stores Secret values keyed by namespace/name/key.
+type fakeSecretReconciler struct {
+	acktypes.Reconciler
+	values map[string]string
+}
+
+func (r *fakeSecretReconciler) SecretValueFromReference(
+	_ context.Context,
+	ref *ackv1alpha1.SecretKeyReference,
+) (string, error) {
+	value, ok := r.values[ref.Namespace+"/"+ref.Name+"/"+ref.Key]
+	if !ok {
+		return "", ackerr.SecretNotFound
+	}
+	return value, nil
+}
+
+func (r *fakeSecretReconciler) WriteToSecret(
+	_ context.Context,
+	value string,
+	namespace string,
+	name string,
+	key string,
+) error {
+	r.values[namespace+"/"+name+"/"+key] = value
+	return nil
+}
+
+func TestSyncPreSharedKeys(t *testing.T) {
+	opts := &svcsdktypes.VpnConnectionOptions{
+		TunnelOptions: []svcsdktypes.TunnelOption{
+			{PreSharedKey: aws.String("first")},
+			{PreSharedKey: aws.String("second")},
+		},
+	}
+
+	tt := []struct {
+		id                   string
+		secretNamespace      string
+		enableCrossNamespace bool
+		existing             map[string]string
+		expected             map[string]string
+		expectedErr          bool
+	}{
+		{"defaults to the resource namespace", "", false,
+			map[string]string{},
+			map[string]string{
+				"team/vpn/psk-tunnel1": "first",
+				"team/vpn/psk-tunnel2": "second",
+			},
+			false,
+		},
+		{"same namespace", "team", false,
+			map[string]string{"team/vpn/psk-tunnel1": "first"},
+			map[string]string{
+				"team/vpn/psk-tunnel1": "first",
+				"team/vpn/psk-tunnel2": "second",
+			},
+			false,
+		},
+		{"stale key is replaced", "team", false,
+			map[string]string{
+				"team/vpn/psk-tunnel1": "old",
+				"team/vpn/psk-tunnel2": "second",
+			},
+			map[string]string{
+				"team/vpn/psk-tunnel1": "first",
+				"team/vpn/psk-tunnel2": "second",
+			},
+			false,
+		},
+		{"cross namespace rejected", "other", false,
+			map[string]string{},
+			map[string]string{},
+			true,
+		},
+		{"cross namespace allowed", "other", true,
+			map[string]string{},
+			map[string]string{
+				"other/vpn/psk-tunnel1": "first",
+				"other/vpn/psk-tunnel2": "second",
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			rr := &fakeSecretReconciler{values: tc.existing}
+			rm := &resourceManager{
+				cfg: ackcfg.Config{EnableCrossNamespace: tc.enableCrossNamespace},
+				rr:  rr,
+			}
+			r := &resource{ko: &svcapitypes.VPNConnection{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team"},
+				Spec: svcapitypes.VPNConnectionSpec{
+					PreSharedKeySecretRef: &ackv1alpha1.SecretKeyReference{
+						SecretReference: corev1.SecretReference{Name: "vpn", Namespace: tc.secretNamespace},
+						Key:             "psk",
+					},
+				},
+			}}
+
+			err := rm.syncPreSharedKeys(context.TODO(), r, opts)
+			if tc.expectedErr {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, rr.values)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vpn_connection_route
+
+import (
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// checkForMissingRequiredFields returns true if either the VPN connection ID
+// or the destination CIDR block identifying the static route is not set.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Spec.VPNConnectionID == nil || r.ko.Spec.DestinationCIDRBlock == nil
+}
+
+// findVPNStaticRoute returns the static route of the VPN connection with the
+// given destination CIDR block. Routes of deleted VPN connections, as well as
+// routes that have already been deleted, are never returned.
+func findVPNStaticRoute(
+	conn svcsdktypes.VpnConnection,
+	destinationCIDRBlock *string,
+) *svcsdktypes.VpnStaticRoute {
+	if destinationCIDRBlock == nil {
+		return nil
+	}
+	if conn.State == svcsdktypes.VpnStateDeleting || conn.State == svcsdktypes.VpnStateDeleted {
+		return nil
+	}
+	for i, route := range conn.Routes {
+		if route.DestinationCidrBlock == nil || *route.DestinationCidrBlock != *destinationCIDRBlock {
+			continue
+		}
+		if route.State == svcsdktypes.VpnStateDeleted {
+			return nil
+		}
+		return &conn.Routes[i]
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package vpn_connection_route
+
+import (
+	"testing"
+
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindVPNStaticRoute(t *testing.T) {
+	route := func(cidr string, state svcsdktypes.VpnState) svcsdktypes.VpnStaticRoute {
+		return svcsdktypes.VpnStaticRoute{
+			DestinationCidrBlock: aws.String(cidr),
+			Source:               svcsdktypes.VpnStaticRouteSourceStatic,
+			State:                state,
+		}
+	}
+
+	tt := []struct {
+		id    string
+		conn  svcsdktypes.VpnConnection
+		cidr  *string
+		found bool
+	}{
+		{"no routes",
+			svcsdktypes.VpnConnection{State: svcsdktypes.VpnStateAvailable},
+			aws.String("10.1.0.0/16"), false,
+		},
+		{"matching route",
+			svcsdktypes.VpnConnection{
+				State:  svcsdktypes.VpnStateAvailable,
+				Routes: []svcsdktypes.VpnStaticRoute{route("10.2.0.0/16", svcsdktypes.VpnStateAvailable), route("10.1.0.0/16", svcsdktypes.VpnStatePending)},
+			},
+			aws.String("10.1.0.0/16"), true,
+		},
+		{"deleted route",
+			svcsdktypes.VpnConnection{
+				State:  svcsdktypes.VpnStateAvailable,
+				Routes: []svcsdktypes.VpnStaticRoute{route("10.1.0.0/16", svcsdktypes.VpnStateDeleted)},
+			},
+			aws.String("10.1.0.0/16"), false,
+		},
+		{"deleted connection",
+			svcsdktypes.VpnConnection{
+				State:  svcsdktypes.VpnStateDeleted,
+				Routes: []svcsdktypes.VpnStaticRoute{route("10.1.0.0/16", svcsdktypes.VpnStateAvailable)},
+			},
+			aws.String("10.1.0.0/16"), false,
+		},
+		{"no destination",
+			svcsdktypes.VpnConnection{
+				State:  svcsdktypes.VpnStateAvailable,
+				Routes: []svcsdktypes.VpnStaticRoute{route("10.1.0.0/16", svcsdktypes.VpnStateAvailable)},
+			},
+			nil, false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			r := findVPNStaticRoute(tc.conn, tc.cidr)
+			assert.Equal(t, tc.found, r != nil)
+			if r != nil {
+				assert.Equal(t, *tc.cidr, *r.DestinationCidrBlock)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vpn_gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/aws-controllers-k8s/ec2-controller/pkg/tags"
+)
+
+var (
+	ErrVPNGatewayPending = fmt.Errorf(
+		"VPNGateway in '%v' state, cannot be attached to a VPC",
+		svcsdktypes.VpnStatePending,
+	)
+	ErrVPCAttachmentDetaching = fmt.Errorf(
+		"VPNGateway VPC attachment in '%v' state, cannot be modified or deleted",
+		svcsdktypes.AttachmentStatusDetaching,
+	)
+)
+
+var (
+	requeueWaitWhilePending = ackrequeue.NeededAfter(
+		ErrVPNGatewayPending,
+		5*time.Second,
+	)
+	requeueWaitWhileDetaching = ackrequeue.NeededAfter(
+		ErrVPCAttachmentDetaching,
+		10*time.Second,
+	)
+)
+
+// checkForMissingRequiredFields returns true if the VPNGatewayID used to
+// describe the resource has not been populated yet.
+func (rm *resourceManager) checkForMissingRequiredFields(r *resource) bool {
+	return r.ko.Status.VPNGatewayID == nil
+}
+
+func isVPNGatewayPending(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	return *r.ko.Status.State == string(svcsdktypes.VpnStatePending)
+}
+
+// isVPNGatewayDeleted returns true if the virtual private gateway has been
+// deleted. Deleted gateways stay visible in DescribeVpnGateways for a while
+// after deletion.
+func isVPNGatewayDeleted(r *resource) bool {
+	if r.ko.Status.State == nil {
+		return false
+	}
+	return *r.ko.Status.State == string(svcsdktypes.VpnStateDeleted)
+}
+
+// isVPCAttachmentDetaching returns true if any of the VPC attachments of the
+// virtual private gateway is still being detached.
+func isVPCAttachmentDetaching(r *resource) bool {
+	for _, att := range r.ko.Status.VPCAttachments {
+		if att.State != nil && *att.State == string(svcsdktypes.AttachmentStatusDetaching) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAttachedVPC returns the ID of the VPC the virtual private gateway is
+// attached, or being attached, to. A virtual private gateway can only be
+// attached to a single VPC at a time. If it is not attached, or is being
+// detached, nil is returned.
+func getAttachedVPC(r *resource) *string {
+	for _, att := range r.ko.Status.VPCAttachments {
+		if att.State == nil {
+			continue
+		}
+		if *att.State == string(svcsdktypes.AttachmentStatusAttached) ||
+			*att.State == string(svcsdktypes.AttachmentStatusAttaching) {
+			return att.VPCID
+		}
+	}
+	return nil
+}
+
+func (rm *resourceManager) customUpdateVPNGateway(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateVPNGateway")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	// Default `updated` to `desired` because it is likely
+	// EC2 `modify` APIs do NOT return output, only errors.
+	// If the `modify` calls (i.e. `sync`) do NOT return
+	// an error, then the update was successful and desired.Spec
+	// (now updated.Spec) reflects the latest resource state.
+	updated = rm.concreteResource(desired.DeepCopy())
+
+	if delta.DifferentAt("Spec.VPC") {
+		if err = rm.syncVPCAttachment(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.Sync(
+			ctx, rm.sdkapi, rm.metrics, *latest.ko.Status.VPNGatewayID,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// syncVPCAttachment attaches the virtual private gateway to the desired VPC,
+// detaching it from the currently attached VPC first. Since a virtual private
+// gateway can only be attached to one VPC at a time, the new attachment is
+// only created once the old one is fully detached.
+func (rm *resourceManager) syncVPCAttachment(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncVPCAttachment")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	if isVPNGatewayPending(latest) {
+		return requeueWaitWhilePending
+	}
+	if isVPCAttachmentDetaching(latest) {
+		return requeueWaitWhileDetaching
+	}
+
+	if latest.ko.Spec.VPC != nil {
+		if err = rm.detachFromVPC(ctx, *latest.ko.Spec.VPC, *latest.ko.Status.VPNGatewayID); err != nil {
+			return err
+		}
+		if desired.ko.Spec.VPC != nil {
+			return requeueWaitWhileDetaching
+		}
+		return nil
+	}
+
+	if desired.ko.Spec.VPC != nil {
+		if err = rm.attachToVPC(ctx, *desired.ko.Spec.VPC, *latest.ko.Status.VPNGatewayID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) attachToVPC(
+	ctx context.Context,
+	vpcID string,
+	vgwID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.attachToVPC")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.AttachVpnGatewayInput{
+		VpcId:        &vpcID,
+		VpnGatewayId: &vgwID,
+	}
+
+	_, err = rm.sdkapi.AttachVpnGateway(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "AttachVpnGateway", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rm *resourceManager) detachFromVPC(
+	ctx context.Context,
+	vpcID string,
+	vgwID string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.detachFromVPC")
+	defer func(err error) {
+		exit(err)
+	}(err)
+
+	input := &svcsdk.DetachVpnGatewayInput{
+		VpcId:        &vpcID,
+		VpnGatewayId: &vgwID,
+	}
+
+	_, err = rm.sdkapi.DetachVpnGateway(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "DetachVpnGateway", err)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateTagSpecificationsInCreateRequest adds
+// Tags defined in the Spec to CreateVpnGatewayInput.TagSpecification
+// and ensures the ResourceType is always set to 'vpn-gateway'
+func updateTagSpecificationsInCreateRequest(r *resource,
+	input *svcsdk.CreateVpnGatewayInput) {
+	input.TagSpecifications = nil
+	desiredTagSpecs := svcsdktypes.TagSpecification{}
+	if r.ko.Spec.Tags != nil {
+		requestedTags := []svcsdktypes.Tag{}
+		for _, desiredTag := range r.ko.Spec.Tags {
+			// Add in tags defined in the Spec
+			tag := svcsdktypes.Tag{}
+			if desiredTag.Key != nil && desiredTag.Value != nil {
+				tag.Key = desiredTag.Key
+				tag.Value = desiredTag.Value
+			}
+			requestedTags = append(requestedTags, tag)
+		}
+		desiredTagSpecs.ResourceType = "vpn-gateway"
+		desiredTagSpecs.Tags = requestedTags
+		input.TagSpecifications = []svcsdktypes.TagSpecification{desiredTagSpecs}
+	}
+}
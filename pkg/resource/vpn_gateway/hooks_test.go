@@ -0,0 +1,51 @@
+package vpn_gateway
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAttachedVPC(t *testing.T) {
+	createTestResource := func(attachments ...*svcapitypes.VPCAttachment) *resource {
+		return &resource{
+			ko: &svcapitypes.VPNGateway{
+				Status: svcapitypes.VPNGatewayStatus{
+					VPCAttachments: attachments,
+				},
+			},
+		}
+	}
+	attachment := func(vpcID, state string) *svcapitypes.VPCAttachment {
+		return &svcapitypes.VPCAttachment{
+			VPCID: aws.String(vpcID),
+			State: aws.String(state),
+		}
+	}
+
+	tt := []struct {
+		id        string
+		r         *resource
+		vpcID     *string
+		detaching bool
+	}{
+		{"no attachments", createTestResource(), nil, false},
+		{"attached", createTestResource(attachment("vpc-1", "attached")), aws.String("vpc-1"), false},
+		{"attaching", createTestResource(attachment("vpc-1", "attaching")), aws.String("vpc-1"), false},
+		{"detached", createTestResource(attachment("vpc-1", "detached")), nil, false},
+		{"detaching", createTestResource(attachment("vpc-1", "detaching")), nil, true},
+		{"reattached to another VPC",
+			createTestResource(attachment("vpc-1", "detached"), attachment("vpc-2", "attached")),
+			aws.String("vpc-2"), false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			assert.Equal(t, tc.vpcID, getAttachedVPC(tc.r))
+			assert.Equal(t, tc.detaching, isVPCAttachmentDetaching(tc.r))
+		})
+	}
+}
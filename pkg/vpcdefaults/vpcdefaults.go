@@ -0,0 +1,136 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package vpcdefaults holds the code shared by the DefaultRouteTable,
+// DefaultNetworkACL and DefaultSecurityGroup resources, which adopt the main
+// route table, default network ACL and default security group EC2 creates
+// along with a VPC.
+package vpcdefaults
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+)
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+type vpcsClient interface {
+	DescribeVpcs(context.Context, *svcsdk.DescribeVpcsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeVpcsOutput, error)
+}
+
+// Find reads the resource with the supplied ID through the resource manager
+// returned by newManager. A default resource is managed as the resource it
+// adopts, whose ID is unknown, and which is therefore not found, until it has
+// been adopted by Adopt.
+func Find(
+	ctx context.Context,
+	id *string,
+	newManager func() (acktypes.AWSResourceManager, error),
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if id == nil {
+		return nil, ackerr.NotFound
+	}
+	mgr, err := newManager()
+	if err != nil {
+		return nil, err
+	}
+	observed, err := mgr.ReadOne(ctx, res)
+	if err == ackerr.NotFound || observed == nil {
+		return nil, err
+	}
+	return observed, err
+}
+
+// Adopt reads the resource desired, whose ID has been looked up, and updates
+// it to match the desired state. Once read, the resource is adopted even if
+// the update fails, and the next reconciliation retries the update.
+func Adopt[R any](
+	ctx context.Context,
+	desired R,
+	find func(context.Context, R) (R, error),
+	newDelta func(a R, b R) *ackcompare.Delta,
+	update func(context.Context, R, R, *ackcompare.Delta) (R, error),
+) (R, error) {
+	latest, err := find(ctx, desired)
+	if err != nil {
+		var none R
+		return none, err
+	}
+	delta := newDelta(desired, latest)
+	if len(delta.Differences) == 0 {
+		return latest, nil
+	}
+	updated, err := update(ctx, desired, latest, delta)
+	if err != nil {
+		return latest, err
+	}
+	return updated, nil
+}
+
+// HasIPv6CIDRBlock returns true if an IPv6 CIDR block is associated with the
+// VPC.
+func HasIPv6CIDRBlock(
+	ctx context.Context,
+	client vpcsClient,
+	mr metricsRecorder,
+	vpcID *string,
+) (bool, error) {
+	input := &svcsdk.DescribeVpcsInput{
+		VpcIds: []string{*vpcID},
+	}
+	resp, err := client.DescribeVpcs(ctx, input)
+	mr.RecordAPICall("READ_ONE", "DescribeVpcs", err)
+	if err != nil {
+		return false, err
+	}
+	for _, vpc := range resp.Vpcs {
+		for _, assoc := range vpc.Ipv6CidrBlockAssociationSet {
+			if assoc.Ipv6CidrBlockState != nil &&
+				assoc.Ipv6CidrBlockState.State == svcsdktypes.VpcCidrBlockStateCodeAssociated {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RemoveTags returns the tags whose keys are not in toRemove. It is used to
+// drop the tags set through a default resource when it is deleted.
+func RemoveTags(
+	tags []*svcapitypes.Tag,
+	toRemove []*svcapitypes.Tag,
+) []*svcapitypes.Tag {
+	keys := map[string]bool{}
+	for _, t := range toRemove {
+		if t.Key != nil {
+			keys[*t.Key] = true
+		}
+	}
+	var kept []*svcapitypes.Tag
+	for _, t := range tags {
+		if t.Key == nil || !keys[*t.Key] {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
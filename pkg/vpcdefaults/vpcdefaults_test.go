@@ -0,0 +1,108 @@
+package vpcdefaults
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdopt(t *testing.T) {
+	find := func(_ context.Context, desired string) (string, error) {
+		return "observed", nil
+	}
+	newDelta := func(a, b string) *ackcompare.Delta {
+		delta := ackcompare.NewDelta()
+		if a != b {
+			delta.Add("Spec", a, b)
+		}
+		return delta
+	}
+
+	tt := []struct {
+		id          string
+		desired     string
+		updateErr   error
+		expected    string
+		expectedErr error
+		updated     bool
+	}{
+		{"up to date", "observed", nil, "observed", nil, false},
+		{"updated", "desired", nil, "desired", nil, true},
+		{"adopted when the update fails", "desired", errors.New("boom"), "observed", errors.New("boom"), true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			updated := false
+			update := func(_ context.Context, desired, _ string, _ *ackcompare.Delta) (string, error) {
+				updated = true
+				return desired, tc.updateErr
+			}
+			res, err := Adopt(context.TODO(), tc.desired, find, newDelta, update)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, res)
+			assert.Equal(t, tc.updated, updated)
+		})
+	}
+}
+
+type fakeVPCsClient struct {
+	vpcs []svcsdktypes.Vpc
+}
+
+func (c *fakeVPCsClient) DescribeVpcs(context.Context, *svcsdk.DescribeVpcsInput, ...func(*svcsdk.Options)) (*svcsdk.DescribeVpcsOutput, error) {
+	return &svcsdk.DescribeVpcsOutput{Vpcs: c.vpcs}, nil
+}
+
+type fakeMetricsRecorder struct{}
+
+func (fakeMetricsRecorder) RecordAPICall(string, string, error) {}
+
+func TestHasIPv6CIDRBlock(t *testing.T) {
+	vpcWithBlock := func(state svcsdktypes.VpcCidrBlockStateCode) svcsdktypes.Vpc {
+		return svcsdktypes.Vpc{
+			Ipv6CidrBlockAssociationSet: []svcsdktypes.VpcIpv6CidrBlockAssociation{{
+				Ipv6CidrBlockState: &svcsdktypes.VpcCidrBlockState{State: state},
+			}},
+		}
+	}
+
+	tt := []struct {
+		id       string
+		vpcs     []svcsdktypes.Vpc
+		expected bool
+	}{
+		{"no IPv6 CIDR block", []svcsdktypes.Vpc{{}}, false},
+		{"associated", []svcsdktypes.Vpc{vpcWithBlock(svcsdktypes.VpcCidrBlockStateCodeAssociated)}, true},
+		{"disassociated", []svcsdktypes.Vpc{vpcWithBlock(svcsdktypes.VpcCidrBlockStateCodeDisassociated)}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.id, func(t *testing.T) {
+			ipv6, err := HasIPv6CIDRBlock(
+				context.TODO(), &fakeVPCsClient{vpcs: tc.vpcs}, fakeMetricsRecorder{}, aws.String("vpc-1"),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ipv6)
+		})
+	}
+}
+
+func TestRemoveTags(t *testing.T) {
+	tag := func(key, value string) *svcapitypes.Tag {
+		return &svcapitypes.Tag{Key: aws.String(key), Value: aws.String(value)}
+	}
+	tags := []*svcapitypes.Tag{tag("Name", "main"), tag("team", "network"), tag("owner", "ops")}
+
+	assert.Equal(t,
+		[]*svcapitypes.Tag{tag("owner", "ops")},
+		RemoveTags(tags, []*svcapitypes.Tag{tag("Name", "other"), tag("team", "network")}),
+	)
+	assert.Equal(t, tags, RemoveTags(tags, nil))
+	assert.Nil(t, RemoveTags(tags, tags))
+}
@@ -0,0 +1 @@
+    updateTagSpecificationsInCreateRequest(desired, input)
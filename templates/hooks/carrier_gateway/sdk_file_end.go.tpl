@@ -0,0 +1,23 @@
+{{ $CRD := .CRD }}
+{{ $SDKAPI := .SDKAPI }}
+
+{{/* Generate helper methods for CarrierGateway */}}
+{{- range $specFieldName, $specField := $CRD.Config.Resources.CarrierGateway.Fields }}
+{{- if $specField.From }}
+{{- $operationName := $specField.From.Operation }}
+{{- $operation := (index $SDKAPI.API.Operations $operationName) -}}
+{{- range $cgwRefName, $cgwMemberRefs := $operation.InputRef.Shape.MemberRefs -}}
+{{- if eq $cgwRefName "Tags" }}
+{{- $cgwRef := $cgwMemberRefs.Shape.MemberRef }}
+{{- $cgwRefName = "Tag" }}
+func (rm *resourceManager) new{{ $cgwRefName }}(
+	    c svcapitypes.{{ $cgwRefName }},
+) svcsdktypes.{{ $cgwRefName }} {
+	res := svcsdktypes.{{ $cgwRefName }}{}
+{{ GoCodeSetSDKForStruct $CRD "" "res" $cgwRef "" "c" 1 }}
+	return res
+}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
@@ -0,0 +1,3 @@
+	if isResourceDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
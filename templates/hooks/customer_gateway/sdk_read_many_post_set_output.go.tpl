@@ -0,0 +1,3 @@
+	if isCustomerGatewayDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
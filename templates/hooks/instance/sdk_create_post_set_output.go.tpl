@@ -1,5 +1,6 @@
 
 	setAdditionalFields(resp.Instances[0], ko)
+	setPlacementGroupRef(desired.ko, ko)
 	
 	toAdd, toDelete := computeTagsDelta(desired.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
@@ -5,6 +5,7 @@
 	}
 
 	setAdditionalFields(resp.Reservations[0].Instances[0], ko)
+	setPlacementGroupRef(r.ko, ko)
 
 	if !isRunning(ko) {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, aws.String("waiting for resource to be running"))
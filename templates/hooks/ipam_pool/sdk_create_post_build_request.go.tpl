@@ -0,0 +1 @@
+    updateTagSpecificationsInCreateRequest(desired, input)
\ No newline at end of file
@@ -0,0 +1,4 @@
+	// A pool cannot be deleted while CIDRs are provisioned into it.
+	if err := rm.deprovisionCIDRs(ctx, r); err != nil {
+		return nil, err
+	}
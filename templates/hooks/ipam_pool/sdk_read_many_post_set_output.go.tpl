@@ -0,0 +1,11 @@
+	if isIPAMPoolDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
+	if err := rm.setProvisionedCIDRs(ctx, ko); err != nil {
+		return nil, err
+	}
+	if isIPAMPoolPending(&resource{ko}) || areCIDRsProvisioning(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
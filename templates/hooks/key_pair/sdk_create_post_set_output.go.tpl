@@ -0,0 +1,7 @@
+	if err = rm.writePrivateKey(ctx, desired, resp.KeyMaterial); err != nil {
+		// EC2 only returns the private key when the key pair is created, so a
+		// key pair whose private key could not be stored is unusable. Delete
+		// it so that the next reconciliation creates a new one.
+		rm.deleteKeyPair(ctx, resp.KeyPairId)
+		return nil, err
+	}
@@ -0,0 +1,6 @@
+	if isImportMode(desired) {
+		return rm.importKeyPair(ctx, desired)
+	}
+	if err = rm.checkPrivateKeySecret(ctx, desired); err != nil {
+		return nil, err
+	}
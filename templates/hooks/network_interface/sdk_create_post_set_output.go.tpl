@@ -0,0 +1,5 @@
+	// Secondary addresses and prefixes, the source/destination check and the
+	// instance attachment cannot be provided in the create request; they are
+	// applied by customUpdateNetworkInterface on the next reconciliation,
+	// which the runtime requeues because the resource is not synced.
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("network interface created, requeue for updates"), nil)
@@ -0,0 +1,10 @@
+	if isAttachmentDetaching(r) {
+		return nil, requeueWaitWhileDetaching
+	}
+	if getAttachedInstance(r) != nil {
+		if err = rm.detachNetworkInterface(ctx, *r.ko.Status.Attachment.AttachmentID); err != nil {
+			return nil, err
+		}
+		// The network interface cannot be deleted while it is in use.
+		return nil, requeueWaitWhileDetaching
+	}
@@ -0,0 +1,2 @@
+	setObservedFields(ko, resp.NetworkInterfaces[0])
+	setAttachment(ko)
@@ -0,0 +1,8 @@
+	if isPlacementGroupDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
+	if isPlacementGroupPending(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
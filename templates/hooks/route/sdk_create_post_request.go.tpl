@@ -0,0 +1,7 @@
+	if err != nil {
+		// The route was not created, so its destination is not owned by
+		// this resource.
+		if releaseErr := rm.releaseDestination(ctx, desired); releaseErr != nil {
+			return nil, releaseErr
+		}
+	}
@@ -0,0 +1,5 @@
+	// The destination is claimed before the route is created, so that the
+	// RouteTable resource managing the route table never deletes it.
+	if err = rm.claimDestination(ctx, desired); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,7 @@
+	route := findRoute(resp.RouteTables[0], ko)
+	if route == nil {
+		return nil, ackerr.NotFound
+	}
+	setRouteTarget(ko, route)
+	ko.Status.Origin = aws.String(string(route.Origin))
+	ko.Status.State = aws.String(string(route.State))
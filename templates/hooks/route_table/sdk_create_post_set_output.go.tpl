@@ -13,9 +13,23 @@
 		}
 	}
 
+	if len(ko.Spec.PropagatingVPNGateways) > 0 {
+		if err := rm.syncVGWRoutePropagations(ctx, &resource{ko}, nil); err != nil {
+			return nil, err
+		}
+	}
+
 	toAdd, toDelete := computeTagsDelta(desired.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
 		// if desired tags and response tags are equal,
 		// then assign desired tags to maintain tag order
 		ko.Spec.Tags = desired.ko.Spec.Tags
 	}
+
+	// The main route table and gateway associations are made by the update
+	// path, so that a failure does not fail the creation of the route table.
+	if aws.ToBool(desired.ko.Spec.Main) || getEdgeGatewayID(desired.ko) != nil {
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("RouteTable created, requeue to sync its associations"), nil)
+		err = ackrequeue.NeededAfter(fmt.Errorf("RouteTable created but its associations need to be synced"), time.Second)
+		return &resource{ko}, err
+	}
@@ -0,0 +1,9 @@
+	// A main route table cannot be deleted, the original main route table of
+	// the VPC is restored first.
+	if err = rm.restoreMainRouteTable(ctx, r); err != nil {
+		return nil, err
+	}
+	// Neither can a route table associated with a gateway.
+	if err = rm.disassociateGateway(ctx, r); err != nil {
+		return nil, err
+	}
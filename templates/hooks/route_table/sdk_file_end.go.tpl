@@ -74,6 +74,21 @@ func (rm *resourceManager) new{{ $deleteInputName }}(
 	return res
 }
 
+{{/* Replace operation for Routes */}}
+
+{{- $replaceInputRef := (index $SDKAPI.API.Operations "ReplaceRoute").InputRef }}
+{{- $replaceInputName := $replaceInputRef.ShapeName }}
+
+func (rm *resourceManager) new{{ $replaceInputName }}(
+	c svcapitypes.CreateRouteInput,
+) *svcsdk.{{ $replaceInputName }} {
+	res := &svcsdk.{{ $replaceInputName }}{}
+
+{{ GoCodeSetSDKForStruct $CRD "" "res" $replaceInputRef "" "c" 1 }}
+
+	return res
+}
+
 {{/* Setter for Route */}}
 
 {{- $routeRef := (index (index $SDKAPI.API.Shapes "RouteTable").MemberRefs "Routes").Shape.MemberRef }}
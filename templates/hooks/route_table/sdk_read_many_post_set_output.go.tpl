@@ -2,6 +2,17 @@
     if found {
         rm.addRoutesToStatus(ko, resp.RouteTables[0])
     }
+	ko.Spec.PropagatingVPNGateways = getPropagatingVPNGatewayIDs(ko.Status.PropagatingVGWs)
+	// Spec.Main is only reported as false when it is set in the resource.
+	if getMainAssociationID(ko.Status.Associations) != nil {
+		ko.Spec.Main = aws.Bool(true)
+	} else if ko.Spec.Main != nil {
+		ko.Spec.Main = aws.Bool(false)
+	}
+	setGatewayIDs(ko)
+	// The tags with which Route resources claim routes of the route table are
+	// not managed through Spec.Tags.
+	ko.Spec.Tags, ko.Status.RouteOwners = getRouteOwners(ko.Spec.Tags)
 	toAdd, toDelete := computeTagsDelta(r.ko.Spec.Tags, ko.Spec.Tags)
 	if len(toAdd) == 0 && len(toDelete) == 0 {
 		// if resource's initial tags and response tags are equal,
@@ -21,3 +32,15 @@
 			ko.Spec.Routes[i].GatewayID = nil
 		}
 	}
+
+	// Routes to an instance are described with both the instance and its
+	// network interface as target, only the one set in the resource is kept.
+	normalizeInstanceRoutes(r.ko.Spec.Routes, ko.Spec.Routes)
+
+	// The failures of routes that have been synced since are dropped.
+	ko.Status.RouteFailures = getRemainingRouteFailures(
+		ko.Status.RouteFailures, r.ko.Spec.Routes,
+		removePropagatedRoutes(ko.Spec.Routes, ko.Status.RouteStatuses),
+	)
+	ko.Status.UnmanagedRoutes = getUnmanagedRoutes(r.ko, ko.Status.RouteStatuses, ko.Status.RouteOwners)
+	setBlackholeRoutesCondition(&resource{ko}, r.ko.Spec.Routes)
\ No newline at end of file
@@ -6,10 +6,13 @@
         rm.addRulesToSpec(ko, resp.SecurityGroups[0])
     	
         // A ReadOne call for SecurityGroup Rules (NOT SecurityGroups)
-	    // is made to refresh Status.Rules
-	    if rules, err := rm.getRules(ctx, &resource{ko}); err != nil {
+	    // is made to refresh Status.Rules, and to leave the rules managed
+	    // by SecurityGroupIngress and SecurityGroupEgress resources out of
+	    // the Spec rules
+	    if sgRules, err := rm.describeRules(ctx, &resource{ko}); err != nil {
 		    return nil, err
 	    } else {
-		    ko.Status.Rules = rules
+		    removeOwnedRules(ko, sgRules)
+		    ko.Status.Rules = rm.setResourceSecurityGroupRules(sgRules)
 	    }
     }
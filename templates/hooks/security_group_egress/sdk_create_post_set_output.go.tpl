@@ -0,0 +1,4 @@
+	// The request authorizes the single rule of the resource.
+	if len(resp.SecurityGroupRules) > 0 {
+		ko.Status.SecurityGroupRuleID = resp.SecurityGroupRules[0].SecurityGroupRuleId
+	}
@@ -0,0 +1 @@
+	input.SecurityGroupRuleIds = []string{*r.ko.Status.SecurityGroupRuleID}
@@ -0,0 +1,2 @@
+	updateIPPermissionsInCreateRequest(desired, input)
+	updateTagSpecificationsInCreateRequest(desired, input)
@@ -0,0 +1,10 @@
+	// An outbound rule is not managed by a SecurityGroupIngress resource.
+	if aws.ToBool(resp.SecurityGroupRules[0].IsEgress) {
+		return nil, ackerr.NotFound
+	}
+	if resp.SecurityGroupRules[0].ReferencedGroupInfo != nil {
+		ko.Spec.ReferencedGroupID = resp.SecurityGroupRules[0].ReferencedGroupInfo.GroupId
+	} else {
+		ko.Spec.ReferencedGroupID = nil
+	}
+	ko.Spec.Tags = removeOwnerTag(ko.Spec.Tags)
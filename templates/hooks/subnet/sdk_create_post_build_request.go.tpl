@@ -1 +1,12 @@
-    updateTagSpecificationsInCreateRequest(desired, input)
\ No newline at end of file
+    updateTagSpecificationsInCreateRequest(desired, input)
+
+	// Without a CIDR block or IPAM pool, a free block of the requested
+	// netmask length is picked from the VPC. It is pinned in Spec.CIDRBlock
+	// once the subnet is created.
+	if needsCIDRBlockFromVPC(desired) {
+		unlock := lockVPCCIDRBlocks(*desired.ko.Spec.VPCID)
+		defer unlock()
+		if err = rm.setCIDRBlockFromVPC(ctx, desired, input); err != nil {
+			return nil, err
+		}
+	}
\ No newline at end of file
@@ -13,6 +13,7 @@
 	if desired.ko.Spec.MapPublicIPOnLaunch != nil {
 		ko.Spec.MapPublicIPOnLaunch = desired.ko.Spec.MapPublicIPOnLaunch
 	}
+	setAllocatedCIDRBlock(desired, ko)
     
 	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("subnet created, requeue for updates"), nil)
 	err = ackrequeue.NeededAfter(fmt.Errorf("Reconciling to sync additional fields"), time.Second)
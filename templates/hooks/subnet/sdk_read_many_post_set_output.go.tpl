@@ -18,4 +18,12 @@
 		for i, assoc := range assocs {
 			ko.Spec.RouteTables[i] = assoc.RouteTableId
 		}
+	}
+
+	setAllocatedCIDRBlock(r, ko)
+	setIPv6CIDRBlock(r, ko)
+	if isIPv6CIDRBlockSyncing(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	}
\ No newline at end of file
@@ -0,0 +1,38 @@
+
+	// This prevents reference resolution errors when adopting existing resources where these fields are not provided in the manifest.
+	if ko.Spec.TransitGatewayID == nil && ko.Status.RequesterTgwInfo != nil {
+		ko.Spec.TransitGatewayID = ko.Status.RequesterTgwInfo.TransitGatewayID
+	}
+	if ko.Status.AccepterTgwInfo != nil {
+		if ko.Spec.PeerTransitGatewayID == nil {
+			ko.Spec.PeerTransitGatewayID = ko.Status.AccepterTgwInfo.TransitGatewayID
+		}
+		if ko.Spec.PeerAccountID == nil {
+			ko.Spec.PeerAccountID = ko.Status.AccepterTgwInfo.OwnerID
+		}
+		if ko.Spec.PeerRegion == nil {
+			ko.Spec.PeerRegion = ko.Status.AccepterTgwInfo.Region
+		}
+	}
+
+	res := &resource{ko}
+	if isTransitGatewayPeeringAttachmentDeleted(res) {
+		return nil, ackerr.NotFound
+	}
+	if isTransitGatewayPeeringAttachmentInitiating(res) {
+		return res, requeueWaitWhileInitiating
+	}
+	if isTransitGatewayPeeringAttachmentPending(res) {
+		return res, requeueWaitWhilePending
+	}
+
+	// Artificially trigger detection by delta.DifferentAt("Spec.AcceptRequest").
+	// An available attachment is only reported as accepted by this resource
+	// if the controller accepted it, so that an attachment accepted by the
+	// peer transit gateway owner does not conflict with AcceptRequest false.
+	if isTransitGatewayPeeringAttachmentPendingAcceptance(res) {
+		res.ko.Spec.AcceptRequest = aws.Bool(false)
+	} else if isTransitGatewayPeeringAttachmentAvailable(res) && res.ko.Spec.AcceptRequest != nil &&
+		aws.ToBool(res.ko.Status.RequestAccepted) {
+		res.ko.Spec.AcceptRequest = aws.Bool(true)
+	}
@@ -0,0 +1,3 @@
+	if err = rm.removeAssociationsAndPropagations(ctx, r); err != nil {
+		return nil, err
+	}
\ No newline at end of file
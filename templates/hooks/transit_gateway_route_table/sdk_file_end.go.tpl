@@ -0,0 +1,18 @@
+{{ $CRD := .CRD }}
+{{ $SDKAPI := .SDKAPI }}
+
+{{/* Setter for TransitGatewayRoute */}}
+
+{{- $routeRef := (index (index $SDKAPI.API.Shapes "SearchTransitGatewayRoutesOutput").MemberRefs "Routes").Shape.MemberRef }}
+{{- $routeRefName := $routeRef.ShapeName }}
+
+// set{{ $routeRefName }} sets a resource {{ $routeRefName }} type
+// given the SDK type.
+func (rm *resourceManager) setResource{{ $routeRefName }}(
+    resp svcsdktypes.{{ $routeRefName }},
+) *svcapitypes.{{ $routeRefName }} {
+    res := &svcapitypes.{{ $routeRefName }}{}
+
+{{ GoCodeSetResourceForStruct $CRD "RouteStatuses" "res" $routeRef "resp" $routeRef 1 }}
+    return res
+}
@@ -0,0 +1,28 @@
+	if isResourceDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
+	if isResourcePending(&resource{ko}) {
+		return nil, ackrequeue.Needed(fmt.Errorf("resource is pending"))
+	}
+
+	associations, err := rm.getAssociations(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	} else {
+		ko.Spec.Associations = associations
+	}
+
+	propagations, err := rm.getPropagations(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	} else {
+		ko.Spec.Propagations = propagations
+	}
+
+	routes, err := rm.getRoutes(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	} else {
+		rm.addRoutesToStatus(ko, routes)
+		ko.Spec.Routes = getStaticRoutes(ko.Status.RouteStatuses)
+	}
@@ -0,0 +1,7 @@
+	if ko.Spec.InstanceID != nil {
+		// The instance attachment cannot be provided in the create request; it
+		// is applied by customUpdateVolume once the volume is available.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("volume created, requeue for attachment"), nil)
+		err = ackrequeue.NeededAfter(fmt.Errorf("Reconciling to sync additional fields"), time.Second)
+		return &resource{ko}, err
+	}
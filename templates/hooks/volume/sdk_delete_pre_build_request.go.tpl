@@ -0,0 +1,12 @@
+	if isAttachmentDetaching(r) {
+		return nil, requeueWaitWhileDetaching
+	}
+	if attachments := getAttachments(r); len(attachments) > 0 {
+		for _, att := range attachments {
+			if err = rm.detachVolume(ctx, *r.ko.Status.VolumeID, att); err != nil {
+				return nil, err
+			}
+		}
+		// The volume cannot be deleted while it is in use.
+		return nil, requeueWaitWhileDetaching
+	}
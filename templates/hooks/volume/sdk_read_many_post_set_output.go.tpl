@@ -0,0 +1,12 @@
+	if isVolumeDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
+	setAttachment(ko)
+	if err = rm.setModification(ctx, ko); err != nil {
+		return nil, err
+	}
+	if isVolumeModifying(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("volume modification in progress"), nil)
+	}
@@ -2,9 +2,19 @@
     ko.Spec.CIDRBlocks = make([]*string, 1)
     ko.Spec.CIDRBlocks[0] = resp.Vpc.CidrBlock
     }
-    rm.syncCIDRBlocks(ctx, desired, &resource{ko})
+    // When the primary CIDR is allocated from an IPAM pool, there are no
+    // secondary CIDRs to associate and the allocated CIDR is kept in Spec.
+    if len(desired.ko.Spec.CIDRBlocks) > 0 {
+        if err := rm.syncCIDRBlocks(ctx, desired, &resource{ko}); err != nil {
+            // Keep the desired CIDR blocks in Spec so the update path
+            // associates the remaining ones.
+            ko.Spec.CIDRBlocks = desired.ko.Spec.CIDRBlocks
+            ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to associate CIDR blocks"), nil)
+            return &resource{ko}, ackrequeue.NeededAfter(err, 5*time.Second)
+        }
+    }
 
-    rm.setSpecCIDRs(ko)
+    rm.setSpecCIDRs(desired, ko)
     err = rm.createAttributes(ctx, &resource{ko})
     if err != nil {
         return nil, err
@@ -24,3 +34,21 @@
 		err = ackrequeue.NeededAfter(fmt.Errorf("VPC created but default security group rules need to be deleted"), time.Second)
 		return &resource{ko}, err
 	}
+
+	// Additional IPv6 CIDR blocks are associated by the update path, which
+	// also waits for the associations to settle.
+	if desired.ko.Spec.IPv6CIDRBlocks != nil {
+		toAdd, toRemove := getIPv6CIDRBlocksDifference(desired.ko.Spec.IPv6CIDRBlocks, ko.Status.IPv6CIDRBlockAssociationSet)
+		if len(toAdd) > 0 || len(toRemove) > 0 {
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to associate IPv6 CIDR blocks"), nil)
+			err = ackrequeue.NeededAfter(fmt.Errorf("VPC created but IPv6 CIDR blocks need to be associated"), time.Second)
+			return &resource{ko}, err
+		}
+	}
+
+	// Secondary IPv4 CIDR blocks associate asynchronously; requeue until
+	// they have settled.
+	if areCIDRBlocksSyncing(&resource{ko}) {
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, aws.String("VPC created, requeue to wait for CIDR block associations"), nil)
+		return &resource{ko}, requeueWaitWhileCIDRBlocksSyncing
+	}
@@ -1,4 +1,4 @@
-	rm.setSpecCIDRs(ko)
+	rm.setSpecCIDRs(r, ko)
 	if dnsAttrs, err := rm.getDNSAttributes(ctx, *ko.Status.VPCID); err != nil {
 		return nil, err
 	} else {
@@ -24,3 +24,8 @@
 	if areCIDRBlocksSyncing(&resource{ko}) {
 		return &resource{ko}, requeueWaitWhileCIDRBlocksSyncing
 	}
+
+	// Desired CIDR blocks that failed to associate are reported in an
+	// advisory condition, and ACK.ResourceSynced stays False until every
+	// desired CIDR block is associated.
+	setCIDRBlocksFailedCondition(r, ko)
@@ -0,0 +1 @@
+	setTunnelOptions(ko, resp.VpnConnection.Options)
@@ -0,0 +1,11 @@
+	setTunnelOptions(ko, resp.VpnConnections[0].Options)
+	res := &resource{ko}
+	if isVPNConnectionDeleted(res) {
+		return nil, ackerr.NotFound
+	}
+	if err = rm.syncPreSharedKeys(ctx, res, resp.VpnConnections[0].Options); err != nil {
+		return nil, err
+	}
+	if isVPNConnectionPending(res) {
+		return res, requeueWaitWhilePending
+	}
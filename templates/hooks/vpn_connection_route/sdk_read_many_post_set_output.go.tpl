@@ -0,0 +1,6 @@
+	route := findVPNStaticRoute(resp.VpnConnections[0], ko.Spec.DestinationCIDRBlock)
+	if route == nil {
+		return nil, ackerr.NotFound
+	}
+	ko.Status.Source = aws.String(string(route.Source))
+	ko.Status.State = aws.String(string(route.State))
@@ -0,0 +1,11 @@
+	if isVPCAttachmentDetaching(r) {
+		return nil, requeueWaitWhileDetaching
+	}
+	if r.ko.Spec.VPC != nil && r.ko.Status.VPNGatewayID != nil {
+		if err = rm.detachFromVPC(ctx, *r.ko.Spec.VPC, *r.ko.Status.VPNGatewayID); err != nil {
+			return nil, err
+		}
+		// The virtual private gateway cannot be deleted until the VPC
+		// attachment is fully detached.
+		return nil, requeueWaitWhileDetaching
+	}
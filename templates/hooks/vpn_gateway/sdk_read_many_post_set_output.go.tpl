@@ -0,0 +1,4 @@
+	ko.Spec.VPC = getAttachedVPC(&resource{ko})
+	if isVPNGatewayDeleted(&resource{ko}) {
+		return nil, ackerr.NotFound
+	}
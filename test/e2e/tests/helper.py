@@ -14,7 +14,7 @@
 """Helper functions for ec2 tests
 """
 
-from typing import Union, Dict
+from typing import Union, Dict, List
 import time
 
 
@@ -157,6 +157,18 @@ class EC2Validator:
     def assert_network_acl(self, network_acl_id: str, exists=True):
         assert (self.get_network_acl(network_acl_id) is not None) == exists
 
+    def get_network_interface(self, eni_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_network_interfaces(NetworkInterfaceIds=[eni_id])
+            if len(aws_res["NetworkInterfaces"]) > 0:
+                return aws_res["NetworkInterfaces"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_network_interface(self, eni_id: str, exists=True):
+        assert (self.get_network_interface(eni_id) is not None) == exists
+
     def get_route_table_association(self, route_table_id: str, subnet_id: str) -> Union[None, Dict]:
         rt = self.get_route_table(route_table_id)
         for assoc in rt["Associations"]:
@@ -251,6 +263,57 @@ class EC2Validator:
             pass
         assert res_found is exists
 
+    def get_transit_gateway_peering_attachment(self, attachment_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_transit_gateway_peering_attachments(TransitGatewayAttachmentIds=[attachment_id])
+            if len(aws_res["TransitGatewayPeeringAttachments"]) > 0:
+                return aws_res["TransitGatewayPeeringAttachments"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_transit_gateway_peering_attachment(self, attachment_id: str, exists=True):
+        res_found = False
+        attachment = self.get_transit_gateway_peering_attachment(attachment_id)
+        # TransitGatewayPeeringAttachment may take awhile to be removed server-side, so
+        # treat 'deleting' and 'deleted' states as resource no longer existing
+        if attachment is not None:
+            res_found = attachment['State'] != "deleting" and attachment['State'] != "deleted"
+        assert res_found is exists
+
+    def get_transit_gateway_route_table(self, route_table_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_transit_gateway_route_tables(TransitGatewayRouteTableIds=[route_table_id])
+            if len(aws_res["TransitGatewayRouteTables"]) > 0:
+                return aws_res["TransitGatewayRouteTables"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_transit_gateway_route_table(self, route_table_id: str, exists=True):
+        res_found = False
+        rt = self.get_transit_gateway_route_table(route_table_id)
+        # TransitGatewayRouteTable may take awhile to be removed server-side, so
+        # treat 'deleting' and 'deleted' states as resource no longer existing
+        if rt is not None:
+            res_found = rt['State'] != "deleting" and rt['State'] != "deleted"
+        assert res_found is exists
+
+    def get_transit_gateway_route_table_associations(self, route_table_id: str) -> list:
+        aws_res = self.ec2_client.get_transit_gateway_route_table_associations(TransitGatewayRouteTableId=route_table_id)
+        return [a for a in aws_res["Associations"] if a["State"] in ("associating", "associated")]
+
+    def get_transit_gateway_route_table_propagations(self, route_table_id: str) -> list:
+        aws_res = self.ec2_client.get_transit_gateway_route_table_propagations(TransitGatewayRouteTableId=route_table_id)
+        return [p for p in aws_res["TransitGatewayRouteTablePropagations"] if p["State"] in ("enabling", "enabled")]
+
+    def get_transit_gateway_static_routes(self, route_table_id: str) -> list:
+        aws_res = self.ec2_client.search_transit_gateway_routes(
+            TransitGatewayRouteTableId=route_table_id,
+            Filters=[{"Name": "type", "Values": ["static"]}],
+        )
+        return aws_res["Routes"]
+
     def get_vpc(self, vpc_id: str) -> Union[None, Dict]:
         try:
             aws_res = self.ec2_client.describe_vpcs(VpcIds=[vpc_id])
@@ -465,3 +528,158 @@ class EC2Validator:
 
     def assert_egress_only_internet_gateway(self, eigw_id: str, exists=True):
         assert (self.get_egress_only_internet_gateway(eigw_id) is not None) == exists
+
+    def get_customer_gateway(self, cgw_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_customer_gateways(CustomerGatewayIds=[cgw_id])
+            if len(aws_res["CustomerGateways"]) > 0:
+                return aws_res["CustomerGateways"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_customer_gateway(self, cgw_id: str, exists=True):
+        res_found = False
+        cgw = self.get_customer_gateway(cgw_id)
+        # Deleted customer gateways stay visible for awhile, so treat
+        # 'deleting' and 'deleted' states as resource no longer existing
+        if cgw is not None:
+            res_found = cgw['State'] != "deleting" and cgw['State'] != "deleted"
+        assert res_found is exists
+
+    def get_vpn_gateway(self, vgw_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_vpn_gateways(VpnGatewayIds=[vgw_id])
+            if len(aws_res["VpnGateways"]) > 0:
+                return aws_res["VpnGateways"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_vpn_gateway(self, vgw_id: str, exists=True):
+        res_found = False
+        vgw = self.get_vpn_gateway(vgw_id)
+        if vgw is not None:
+            res_found = vgw['State'] != "deleting" and vgw['State'] != "deleted"
+        assert res_found is exists
+
+    def get_vpn_connection(self, vpn_connection_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_vpn_connections(VpnConnectionIds=[vpn_connection_id])
+            if len(aws_res["VpnConnections"]) > 0:
+                return aws_res["VpnConnections"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_vpn_connection(self, vpn_connection_id: str, exists=True):
+        res_found = False
+        conn = self.get_vpn_connection(vpn_connection_id)
+        if conn is not None:
+            res_found = conn['State'] != "deleting" and conn['State'] != "deleted"
+        assert res_found is exists
+
+    def get_vpn_connection_routes(self, vpn_connection_id: str) -> list:
+        conn = self.get_vpn_connection(vpn_connection_id)
+        if conn is None:
+            return []
+        return [r for r in conn.get("Routes", []) if r["State"] in ("pending", "available")]
+
+    def get_volume(self, volume_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_volumes(VolumeIds=[volume_id])
+            if len(aws_res["Volumes"]) > 0:
+                return aws_res["Volumes"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_volume(self, volume_id: str, exists=True):
+        res_found = False
+        volume = self.get_volume(volume_id)
+        if volume is not None:
+            res_found = volume['State'] != "deleting" and volume['State'] != "deleted"
+        assert res_found is exists
+
+    def get_key_pair(self, key_pair_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_key_pairs(KeyPairIds=[key_pair_id])
+            if len(aws_res["KeyPairs"]) > 0:
+                return aws_res["KeyPairs"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_key_pair(self, key_pair_id: str, exists=True):
+        res_found = False
+        if self.get_key_pair(key_pair_id) is not None:
+            res_found = True
+        assert res_found is exists
+
+    def get_placement_group(self, group_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_placement_groups(GroupIds=[group_id])
+            if len(aws_res["PlacementGroups"]) > 0:
+                return aws_res["PlacementGroups"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_placement_group(self, group_id: str, exists=True):
+        res_found = False
+        group = self.get_placement_group(group_id)
+        if group is not None:
+            res_found = group['State'] != "deleting" and group['State'] != "deleted"
+        assert res_found is exists
+
+    def get_ipam(self, ipam_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_ipams(IpamIds=[ipam_id])
+            if len(aws_res["Ipams"]) > 0:
+                return aws_res["Ipams"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_ipam(self, ipam_id: str, exists=True):
+        res_found = False
+        ipam = self.get_ipam(ipam_id)
+        if ipam is not None:
+            res_found = ipam['State'] not in ("delete-in-progress", "delete-complete")
+        assert res_found is exists
+
+    def get_ipam_scope(self, ipam_scope_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_ipam_scopes(IpamScopeIds=[ipam_scope_id])
+            if len(aws_res["IpamScopes"]) > 0:
+                return aws_res["IpamScopes"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def assert_ipam_scope(self, ipam_scope_id: str, exists=True):
+        res_found = False
+        scope = self.get_ipam_scope(ipam_scope_id)
+        if scope is not None:
+            res_found = scope['State'] not in ("delete-in-progress", "delete-complete")
+        assert res_found is exists
+
+    def get_ipam_pool(self, ipam_pool_id: str) -> Union[None, Dict]:
+        try:
+            aws_res = self.ec2_client.describe_ipam_pools(IpamPoolIds=[ipam_pool_id])
+            if len(aws_res["IpamPools"]) > 0:
+                return aws_res["IpamPools"][0]
+            return None
+        except self.ec2_client.exceptions.ClientError:
+            return None
+
+    def get_ipam_pool_cidrs(self, ipam_pool_id: str) -> List[Dict]:
+        aws_res = self.ec2_client.get_ipam_pool_cidrs(IpamPoolId=ipam_pool_id)
+        return [c for c in aws_res["IpamPoolCidrs"] if c["State"] != "deprovisioned"]
+
+    def assert_ipam_pool(self, ipam_pool_id: str, exists=True):
+        res_found = False
+        pool = self.get_ipam_pool(ipam_pool_id)
+        if pool is not None:
+            res_found = pool['State'] not in ("delete-in-progress", "delete-complete")
+        assert res_found is exists
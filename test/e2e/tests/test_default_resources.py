@@ -0,0 +1,213 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the DefaultRouteTable, DefaultNetworkACL and
+DefaultSecurityGroup APIs.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.tests.helper import EC2Validator
+
+VPC_RESOURCE_PLURAL = "vpcs"
+IGW_RESOURCE_PLURAL = "internetgateways"
+ROUTE_TABLE_RESOURCE_PLURAL = "defaultroutetables"
+NETWORK_ACL_RESOURCE_PLURAL = "defaultnetworkacls"
+SECURITY_GROUP_RESOURCE_PLURAL = "defaultsecuritygroups"
+
+VPC_CIDR_BLOCK = "10.92.0.0/16"
+CREATE_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 10
+WAIT_PERIOD = 30
+
+
+def create_resource(plural: str, name: str, resource_file: str, replacements: dict):
+    resource_data = load_ec2_resource(
+        resource_file,
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, plural,
+        name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+    return ref, k8s.get_resource(ref)
+
+
+def delete_resource(ref):
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture
+def test_vpc():
+    resource_name = random_suffix_name("default-res-vpc", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["VPC_NAME"] = resource_name
+    replacements["CIDR_BLOCK"] = VPC_CIDR_BLOCK
+    replacements["ENABLE_DNS_SUPPORT"] = "True"
+    replacements["ENABLE_DNS_HOSTNAMES"] = "False"
+    replacements["ENABLE_NETWORK_ADDRESS_USAGE_METRICS"] = "False"
+    replacements["DISALLOW_DEFAULT_SECURITY_GROUP_RULE"] = "False"
+    replacements["TAG_KEY"] = "default-resources-test"
+    replacements["TAG_VALUE"] = "vpc"
+
+    ref, cr = create_resource(VPC_RESOURCE_PLURAL, resource_name, "vpc", replacements)
+
+    yield (ref, cr)
+
+    delete_resource(ref)
+
+
+@pytest.fixture
+def test_internet_gateway(test_vpc):
+    (_, vpc_cr) = test_vpc
+    resource_name = random_suffix_name("default-res-igw", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["INTERNET_GATEWAY_NAME"] = resource_name
+    replacements["VPC_ID"] = vpc_cr["status"]["vpcID"]
+
+    ref, cr = create_resource(IGW_RESOURCE_PLURAL, resource_name, "internet_gateway_vpc_attachment", replacements)
+
+    yield (ref, cr)
+
+    delete_resource(ref)
+
+
+@service_marker
+class TestDefaultResources:
+    def test_default_route_table(self, ec2_client, test_vpc, test_internet_gateway):
+        (vpc_ref, vpc_cr) = test_vpc
+        (_, igw_cr) = test_internet_gateway
+        vpc_id = vpc_cr["status"]["vpcID"]
+        igw_id = igw_cr["status"]["internetGatewayID"]
+        ec2_validator = EC2Validator(ec2_client)
+
+        resource_name = random_suffix_name("default-rtb", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["DEFAULT_ROUTE_TABLE_NAME"] = resource_name
+        replacements["VPC_REF_NAME"] = vpc_ref.name
+        replacements["DEST_CIDR_BLOCK"] = "0.0.0.0/0"
+        replacements["IGW_ID"] = igw_id
+        replacements["TAG_KEY"] = "default-resources-test"
+        replacements["TAG_VALUE"] = "route-table"
+
+        ref, cr = create_resource(ROUTE_TABLE_RESOURCE_PLURAL, resource_name, "default_route_table", replacements)
+        route_table_id = cr["status"]["routeTableID"]
+        assert cr["spec"]["vpcID"] == vpc_id
+
+        # The adopted route table is the main route table of the VPC
+        route_table = ec2_validator.get_route_table(route_table_id)
+        assert route_table["VpcId"] == vpc_id
+        assert any(assoc.get("Main") for assoc in route_table["Associations"])
+        ec2_validator.assert_route(route_table_id, igw_id, "CreateRoute")
+
+        # Deleting the resource resets the route table, which is kept
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_route_table(route_table_id)
+        ec2_validator.assert_route(route_table_id, igw_id, "CreateRoute", exists=False)
+        route_table = ec2_validator.get_route_table(route_table_id)
+        assert "default-resources-test" not in [t["Key"] for t in route_table.get("Tags", [])]
+
+    def test_default_network_acl(self, ec2_client, test_vpc):
+        (vpc_ref, vpc_cr) = test_vpc
+        vpc_id = vpc_cr["status"]["vpcID"]
+        ec2_validator = EC2Validator(ec2_client)
+
+        resource_name = random_suffix_name("default-acl", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["DEFAULT_NETWORK_ACL_NAME"] = resource_name
+        replacements["VPC_REF_NAME"] = vpc_ref.name
+        replacements["CIDR_BLOCK"] = VPC_CIDR_BLOCK
+        replacements["TAG_KEY"] = "default-resources-test"
+        replacements["TAG_VALUE"] = "network-acl"
+
+        ref, cr = create_resource(NETWORK_ACL_RESOURCE_PLURAL, resource_name, "default_network_acl", replacements)
+        network_acl_id = cr["status"]["id"]
+
+        network_acl = ec2_validator.get_network_acl(network_acl_id)
+        assert network_acl["VpcId"] == vpc_id
+        assert network_acl["IsDefault"] is True
+        ingress = [e for e in network_acl["Entries"] if not e["Egress"] and e["RuleNumber"] == 100]
+        assert len(ingress) == 1
+        assert ingress[0]["CidrBlock"] == VPC_CIDR_BLOCK
+        assert ingress[0]["PortRange"] == {"From": 443, "To": 443}
+
+        # Deleting the resource restores the entries allowing all traffic
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_network_acl(network_acl_id)
+        network_acl = ec2_validator.get_network_acl(network_acl_id)
+        ingress = [e for e in network_acl["Entries"] if not e["Egress"] and e["RuleNumber"] == 100]
+        assert len(ingress) == 1
+        assert ingress[0]["CidrBlock"] == "0.0.0.0/0"
+        assert ingress[0]["Protocol"] == "-1"
+
+    def test_default_security_group(self, ec2_client, test_vpc):
+        (vpc_ref, vpc_cr) = test_vpc
+        vpc_id = vpc_cr["status"]["vpcID"]
+        ec2_validator = EC2Validator(ec2_client)
+
+        resource_name = random_suffix_name("default-sg", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["DEFAULT_SECURITY_GROUP_NAME"] = resource_name
+        replacements["VPC_REF_NAME"] = vpc_ref.name
+        replacements["CIDR_BLOCK"] = VPC_CIDR_BLOCK
+        replacements["TAG_KEY"] = "default-resources-test"
+        replacements["TAG_VALUE"] = "security-group"
+
+        ref, cr = create_resource(SECURITY_GROUP_RESOURCE_PLURAL, resource_name, "default_security_group", replacements)
+        sg_id = cr["status"]["id"]
+
+        # Only the desired ingress rule is left, and no egress rule
+        sg = ec2_validator.get_security_group(sg_id)
+        assert sg["VpcId"] == vpc_id
+        assert sg["GroupName"] == "default"
+        assert len(sg["IpPermissions"]) == 1
+        assert sg["IpPermissions"][0]["FromPort"] == 443
+        assert sg["IpPermissions"][0]["IpRanges"][0]["CidrIp"] == VPC_CIDR_BLOCK
+        assert len(sg["IpPermissionsEgress"]) == 0
+
+        # Deleting the resource restores the default rules
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_security_group(sg_id)
+        sg = ec2_validator.get_security_group(sg_id)
+        assert len(sg["IpPermissions"]) == 1
+        assert sg["IpPermissions"][0]["IpProtocol"] == "-1"
+        assert sg["IpPermissions"][0]["UserIdGroupPairs"][0]["GroupId"] == sg_id
+        assert len(sg["IpPermissionsEgress"]) == 1
+        assert sg["IpPermissionsEgress"][0]["IpRanges"][0]["CidrIp"] == "0.0.0.0/0"
@@ -0,0 +1,264 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the IPAM, IPAMScope and IPAMPool APIs.
+"""
+
+import pytest
+import time
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.tests.helper import EC2Validator
+from e2e.tests.test_network_interface import create_resource
+
+IPAM_PLURAL = "ipams"
+IPAM_SCOPE_PLURAL = "ipamscopes"
+IPAM_POOL_PLURAL = "ipampools"
+VPC_PLURAL = "vpcs"
+SUBNET_PLURAL = "subnets"
+
+IPAM_POOL_CIDR = "10.100.0.0/16"
+IPAM_POOL_UPDATED_CIDR = "10.101.0.0/16"
+NESTED_IPAM_POOL_CIDR = "10.100.0.0/20"
+
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 30
+
+
+def delete_resource(ref):
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture(scope="module")
+def ipam():
+    resource_name = random_suffix_name("ipam-ack-test", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["IPAM_NAME"] = resource_name
+    replacements["IPAM_DESCRIPTION"] = "initial description"
+    replacements["IPAM_TIER"] = "free"
+    replacements["TAG_KEY"] = "initialtagkey"
+    replacements["TAG_VALUE"] = "initialtagvalue"
+
+    ref, cr = create_resource(IPAM_PLURAL, resource_name, "ipam", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+    yield (ref, k8s.get_resource(ref))
+
+    delete_resource(ref)
+
+
+@pytest.fixture(scope="module")
+def ipam_scope(ipam):
+    (ipam_ref, _) = ipam
+
+    resource_name = random_suffix_name("ipam-scope-test", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["IPAM_SCOPE_NAME"] = resource_name
+    replacements["IPAM_SCOPE_DESCRIPTION"] = "initial description"
+    replacements["IPAM_REF_NAME"] = ipam_ref.name
+
+    ref, cr = create_resource(IPAM_SCOPE_PLURAL, resource_name, "ipam_scope", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+    yield (ref, k8s.get_resource(ref))
+
+    delete_resource(ref)
+
+
+@pytest.fixture(scope="module")
+def ipam_pool(ipam_scope):
+    (scope_ref, _) = ipam_scope
+
+    resource_name = random_suffix_name("ipam-pool-test", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["IPAM_POOL_NAME"] = resource_name
+    replacements["IPAM_SCOPE_REF_NAME"] = scope_ref.name
+    replacements["IPAM_POOL_CIDR"] = IPAM_POOL_CIDR
+    replacements["ALLOCATION_DEFAULT_NETMASK_LENGTH"] = "20"
+
+    ref, cr = create_resource(IPAM_POOL_PLURAL, resource_name, "ipam_pool", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+    yield (ref, k8s.get_resource(ref))
+
+    delete_resource(ref)
+
+
+@service_marker
+@pytest.mark.canary
+class TestIPAM:
+    def test_ipam_update(self, ec2_client, ipam):
+        (ref, cr) = ipam
+        ipam_id = cr["status"]["ipamID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_ipam(ipam_id)
+
+        updates = {
+            "spec": {
+                "description": "updated description",
+                "tags": [{"key": "updatedtagkey", "value": "updatedtagvalue"}],
+            },
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        aws_ipam = ec2_validator.get_ipam(ipam_id)
+        assert aws_ipam["Description"] == "updated description"
+        assert aws_ipam["Tags"] == [{"Key": "updatedtagkey", "Value": "updatedtagvalue"}]
+
+    def test_ipam_scope_update(self, ec2_client, ipam, ipam_scope):
+        (_, ipam_cr) = ipam
+        (ref, cr) = ipam_scope
+        scope_id = cr["status"]["ipamScopeID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        scope = ec2_validator.get_ipam_scope(scope_id)
+        assert scope["IpamArn"] == ipam_cr["status"]["ackResourceMetadata"]["arn"]
+        assert scope["IpamScopeType"] == "private"
+
+        k8s.patch_custom_resource(ref, {"spec": {"description": "updated description"}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        scope = ec2_validator.get_ipam_scope(scope_id)
+        assert scope["Description"] == "updated description"
+
+    def test_ipam_pool_cidrs(self, ec2_client, ipam_pool):
+        (ref, cr) = ipam_pool
+        pool_id = cr["status"]["ipamPoolID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        cidrs = ec2_validator.get_ipam_pool_cidrs(pool_id)
+        assert [c["Cidr"] for c in cidrs] == [IPAM_POOL_CIDR]
+        assert cr["status"]["provisionedCIDRs"][0]["state"] == "provisioned"
+
+        # Replace the provisioned CIDR and change an allocation rule
+        updates = {
+            "spec": {
+                "cidrs": [IPAM_POOL_UPDATED_CIDR],
+                "allocationDefaultNetmaskLength": 24,
+            },
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+        cidrs = ec2_validator.get_ipam_pool_cidrs(pool_id)
+        assert [c["Cidr"] for c in cidrs] == [IPAM_POOL_UPDATED_CIDR]
+        pool = ec2_validator.get_ipam_pool(pool_id)
+        assert pool["AllocationDefaultNetmaskLength"] == 24
+
+        # Restore the original CIDR for the tests below
+        k8s.patch_custom_resource(ref, {"spec": {"cidrs": [IPAM_POOL_CIDR]}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+    def test_nested_ipam_pool(self, ec2_client, ipam_scope, ipam_pool):
+        (scope_ref, _) = ipam_scope
+        (pool_ref, pool_cr) = ipam_pool
+
+        resource_name = random_suffix_name("ipam-pool-nested", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["IPAM_POOL_NAME"] = resource_name
+        replacements["IPAM_POOL_LOCALE"] = ec2_client.meta.region_name
+        replacements["IPAM_SCOPE_REF_NAME"] = scope_ref.name
+        replacements["SOURCE_IPAM_POOL_REF_NAME"] = pool_ref.name
+        replacements["IPAM_POOL_CIDR"] = NESTED_IPAM_POOL_CIDR
+        replacements["ALLOCATION_DEFAULT_NETMASK_LENGTH"] = "24"
+
+        ref, cr = create_resource(IPAM_POOL_PLURAL, resource_name, "ipam_pool_nested", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+        cr = k8s.get_resource(ref)
+        pool_id = cr["status"]["ipamPoolID"]
+        assert cr["spec"]["sourceIPAMPoolID"] == pool_cr["status"]["ipamPoolID"]
+        assert cr["status"]["poolDepth"] == 2
+
+        ec2_validator = EC2Validator(ec2_client)
+        cidrs = ec2_validator.get_ipam_pool_cidrs(pool_id)
+        assert [c["Cidr"] for c in cidrs] == [NESTED_IPAM_POOL_CIDR]
+
+        vpc_name = random_suffix_name("vpc-ipam", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VPC_NAME"] = vpc_name
+        replacements["IPAM_POOL_REF_NAME"] = resource_name
+        replacements["IPV4_NETMASK_LENGTH"] = "24"
+
+        vpc_ref, vpc_cr = create_resource(VPC_PLURAL, vpc_name, "vpc_ipam", replacements)
+        assert k8s.wait_on_condition(vpc_ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        vpc_cr = k8s.get_resource(vpc_ref)
+        vpc = ec2_validator.get_vpc(vpc_cr["status"]["vpcID"])
+        assert vpc["CidrBlock"].startswith("10.100.")
+        assert vpc["CidrBlock"].endswith("/24")
+
+        self.assert_subnet_from_vpc_pool(ec2_client, scope_ref, vpc_ref, vpc)
+
+        _, deleted = k8s.delete_custom_resource(vpc_ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_ipam_pool(pool_id, exists=False)
+
+    def assert_subnet_from_vpc_pool(self, ec2_client, scope_ref, vpc_ref, vpc):
+        # Subnets are allocated from a resource planning pool sourced from
+        # the VPC
+        pool_name = random_suffix_name("ipam-pool-vpc", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["IPAM_POOL_NAME"] = pool_name
+        replacements["IPAM_POOL_LOCALE"] = ec2_client.meta.region_name
+        replacements["IPAM_SCOPE_REF_NAME"] = scope_ref.name
+        replacements["VPC_ID"] = vpc["VpcId"]
+        replacements["IPAM_POOL_CIDR"] = vpc["CidrBlock"]
+
+        pool_ref, _ = create_resource(IPAM_POOL_PLURAL, pool_name, "ipam_pool_vpc", replacements)
+        assert k8s.wait_on_condition(pool_ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+        subnet_name = random_suffix_name("subnet-ipam", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["SUBNET_NAME"] = subnet_name
+        replacements["VPC_REF_NAME"] = vpc_ref.name
+        replacements["IPAM_POOL_REF_NAME"] = pool_name
+        replacements["IPV4_NETMASK_LENGTH"] = "28"
+
+        subnet_ref, _ = create_resource(SUBNET_PLURAL, subnet_name, "subnet_ipam", replacements)
+        assert k8s.wait_on_condition(subnet_ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        subnet_cr = k8s.get_resource(subnet_ref)
+        subnet = EC2Validator(ec2_client).get_subnet(subnet_cr["status"]["subnetID"])
+        assert subnet["CidrBlock"].endswith("/28")
+        # The allocated CIDR is recorded in status and is not drift
+        assert subnet_cr["status"]["allocatedCIDRBlock"] == subnet["CidrBlock"]
+        assert "cidrBlock" not in subnet_cr["spec"]
+
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(subnet_ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        _, deleted = k8s.delete_custom_resource(subnet_ref, 3, 10)
+        assert deleted is True
+        _, deleted = k8s.delete_custom_resource(pool_ref, 10, 30)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
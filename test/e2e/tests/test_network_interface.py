@@ -0,0 +1,179 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the NetworkInterface API.
+"""
+
+import ipaddress
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+from e2e.tests.test_instance import get_ami_id
+
+RESOURCE_PLURAL = "networkinterfaces"
+INSTANCE_PLURAL = "instances"
+INSTANCE_TYPE = "m4.large"
+
+CREATE_WAIT_AFTER_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 10
+
+
+def create_resource(plural: str, resource_name: str, resource_file: str, replacements: dict):
+    resource_data = load_ec2_resource(
+        resource_file,
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, plural,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    return ref, cr
+
+
+def get_free_addresses(ec2_client, subnet_id: str, count: int) -> list:
+    # Pick addresses from the end of the shared subnet, which are the least
+    # likely to be in use by other tests.
+    subnet = EC2Validator(ec2_client).get_subnet(subnet_id)
+    hosts = list(ipaddress.ip_network(subnet["CidrBlock"]).hosts())
+    return [str(h) for h in hosts[-(count + 10):-10]]
+
+
+@pytest.fixture
+def simple_instance(ec2_client):
+    resource_name = random_suffix_name("eni-instance", 24)
+    subnet_id = get_bootstrap_resources().SharedTestVPC.public_subnets.subnet_ids[0]
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["INSTANCE_NAME"] = resource_name
+    replacements["INSTANCE_AMI_ID"] = get_ami_id(ec2_client)
+    replacements["INSTANCE_TYPE"] = INSTANCE_TYPE
+    replacements["INSTANCE_SUBNET_ID"] = subnet_id
+    replacements["INSTANCE_TAG_KEY"] = "owner"
+    replacements["INSTANCE_TAG_VAL"] = "ack-controller"
+
+    ref, cr = create_resource(INSTANCE_PLURAL, resource_name, "instance", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@service_marker
+@pytest.mark.canary
+class TestNetworkInterface:
+    def test_crud(self, ec2_client):
+        resource_name = random_suffix_name("eni-ack-test", 24)
+        subnet_id = get_bootstrap_resources().SharedTestVPC.public_subnets.subnet_ids[0]
+        primary, secondary, added = get_free_addresses(ec2_client, subnet_id, 3)
+
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["NETWORK_INTERFACE_NAME"] = resource_name
+        replacements["DESCRIPTION"] = "initial description"
+        replacements["SUBNET_ID"] = subnet_id
+        replacements["PRIVATE_IP_ADDRESS"] = primary
+        replacements["SECONDARY_PRIVATE_IP_ADDRESS"] = secondary
+        replacements["TAG_KEY"] = "initialtagkey"
+        replacements["TAG_VALUE"] = "initialtagvalue"
+
+        ref, cr = create_resource(RESOURCE_PLURAL, resource_name, "network_interface", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        cr = k8s.get_resource(ref)
+        eni_id = cr["status"]["networkInterfaceID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_network_interface(eni_id)
+
+        eni = ec2_validator.get_network_interface(eni_id)
+        assert eni["PrivateIpAddress"] == primary
+        assert eni["Description"] == "initial description"
+        assert eni["SourceDestCheck"] is True
+        secondaries = [a["PrivateIpAddress"] for a in eni["PrivateIpAddresses"] if not a["Primary"]]
+        assert secondaries == [secondary]
+
+        # Replace the secondary address, update the description and disable
+        # the source/destination check
+        updates = {
+            "spec": {
+                "description": "updated description",
+                "secondaryPrivateIPAddresses": [added],
+                "sourceDestCheck": False,
+            },
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        eni = ec2_validator.get_network_interface(eni_id)
+        assert eni["Description"] == "updated description"
+        assert eni["SourceDestCheck"] is False
+        secondaries = [a["PrivateIpAddress"] for a in eni["PrivateIpAddresses"] if not a["Primary"]]
+        assert secondaries == [added]
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_network_interface(eni_id, exists=False)
+
+    def test_attachment(self, ec2_client, simple_instance):
+        (instance_ref, instance_cr) = simple_instance
+        instance_id = instance_cr["status"]["instanceID"]
+        instance_name = instance_ref.name
+
+        resource_name = random_suffix_name("eni-ack-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["NETWORK_INTERFACE_NAME"] = resource_name
+        replacements["SUBNET_ID"] = instance_cr["spec"]["subnetID"]
+        replacements["INSTANCE_NAME"] = instance_name
+
+        ref, cr = create_resource(RESOURCE_PLURAL, resource_name, "network_interface_attachment", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        cr = k8s.get_resource(ref)
+        eni_id = cr["status"]["networkInterfaceID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        eni = ec2_validator.get_network_interface(eni_id)
+        assert eni["Attachment"]["InstanceId"] == instance_id
+        assert eni["Attachment"]["DeviceIndex"] == 1
+
+        # Deleting the network interface detaches it from the instance first
+        _, deleted = k8s.delete_custom_resource(ref, 6, 10)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_network_interface(eni_id, exists=False)
@@ -0,0 +1,112 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the PlacementGroup API.
+"""
+
+import pytest
+import time
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+from e2e.tests.test_instance import get_ami_id
+from e2e.tests.test_network_interface import create_resource
+
+RESOURCE_PLURAL = "placementgroups"
+INSTANCE_PLURAL = "instances"
+# Cluster placement groups require an instance type that supports them
+INSTANCE_TYPE = "c5.large"
+
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 10
+
+
+@pytest.fixture
+def cluster_placement_group():
+    resource_name = random_suffix_name("pg-ack-test", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["PLACEMENT_GROUP_NAME"] = resource_name
+    replacements["STRATEGY"] = "cluster"
+    replacements["TAG_KEY"] = "initialtagkey"
+    replacements["TAG_VALUE"] = "initialtagvalue"
+
+    ref, cr = create_resource(RESOURCE_PLURAL, resource_name, "placement_group", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@service_marker
+@pytest.mark.canary
+class TestPlacementGroup:
+    def test_create_update_delete(self, ec2_client, cluster_placement_group):
+        (ref, cr) = cluster_placement_group
+        group_id = cr["status"]["groupID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_placement_group(group_id)
+        group = ec2_validator.get_placement_group(group_id)
+        assert group["Strategy"] == "cluster"
+        assert group["State"] == "available"
+
+        updates = {
+            "spec": {"tags": [{"key": "updatedtagkey", "value": "updatedtagvalue"}]},
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        group = ec2_validator.get_placement_group(group_id)
+        assert group["Tags"] == [{"Key": "updatedtagkey", "Value": "updatedtagvalue"}]
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_placement_group(group_id, exists=False)
+
+    def test_instance_reference(self, ec2_client, cluster_placement_group):
+        (pg_ref, pg_cr) = cluster_placement_group
+
+        resource_name = random_suffix_name("pg-instance", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["INSTANCE_NAME"] = resource_name
+        replacements["INSTANCE_AMI_ID"] = get_ami_id(ec2_client)
+        replacements["INSTANCE_TYPE"] = INSTANCE_TYPE
+        replacements["INSTANCE_SUBNET_ID"] = get_bootstrap_resources().SharedTestVPC.public_subnets.subnet_ids[0]
+        replacements["PLACEMENT_GROUP_REF_NAME"] = pg_ref.name
+
+        ref, cr = create_resource(INSTANCE_PLURAL, resource_name, "instance_placement_group", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=20)
+
+        cr = k8s.get_resource(ref)
+        instance = ec2_client.describe_instances(
+            InstanceIds=[cr["status"]["instanceID"]],
+        )["Reservations"][0]["Instances"][0]
+        assert instance["Placement"]["GroupName"] == pg_cr["spec"]["groupName"]
+        # The reference is kept in the spec
+        assert cr["spec"]["placement"]["groupRef"]["from"]["name"] == pg_ref.name
+
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
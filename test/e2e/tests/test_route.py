@@ -0,0 +1,134 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Route API.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+
+RESOURCE_PLURAL = "routes"
+ROUTE_TABLE_PLURAL = "routetables"
+
+CREATE_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+
+@pytest.fixture
+def route_table():
+    test_vpc = get_bootstrap_resources().SharedTestVPC
+    resource_name = random_suffix_name("route-owner-rtb", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["ROUTE_TABLE_NAME"] = resource_name
+    replacements["VPC_ID"] = test_vpc.vpc_id
+    replacements["IGW_ID"] = test_vpc.public_subnets.route_table.internet_gateway.internet_gateway_id
+    replacements["DEST_CIDR_BLOCK"] = "192.168.0.0/24"
+
+    resource_data = load_ec2_resource(
+        "route_table",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, ROUTE_TABLE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+def get_route(ec2_client, route_table_id: str, destination_cidr_block: str):
+    aws_res = ec2_client.describe_route_tables(RouteTableIds=[route_table_id])
+    for route in aws_res["RouteTables"][0]["Routes"]:
+        if route.get("DestinationCidrBlock") == destination_cidr_block:
+            return route
+    return None
+
+@service_marker
+@pytest.mark.canary
+class TestRoute:
+    def test_create_delete(self, ec2_client, route_table):
+        (rtb_ref, rtb_cr) = route_table
+        route_table_id = rtb_cr["status"]["routeTableID"]
+        igw_id = get_bootstrap_resources().SharedTestVPC.public_subnets.route_table.internet_gateway.internet_gateway_id
+        destination_cidr_block = "172.31.0.0/24"
+
+        resource_name = random_suffix_name("route-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["ROUTE_NAME"] = resource_name
+        replacements["ROUTE_TABLE_REF_NAME"] = rtb_ref.name
+        replacements["DESTINATION_CIDR_BLOCK"] = destination_cidr_block
+        replacements["IGW_ID"] = igw_id
+
+        resource_data = load_ec2_resource(
+            "route",
+            additional_replacements=replacements,
+        )
+        logging.debug(resource_data)
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resource_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+        assert cr is not None
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        cr = k8s.get_resource(ref)
+        assert cr["spec"]["routeTableID"] == route_table_id
+        assert cr["status"]["state"] == "active"
+        route = get_route(ec2_client, route_table_id, destination_cidr_block)
+        assert route is not None
+        assert route["GatewayId"] == igw_id
+
+        # The RouteTable, which does not declare the route, leaves it in place
+        # and reports its owner
+        k8s.patch_custom_resource(rtb_ref, {"spec": {"routes": []}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(rtb_ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        rtb_cr = k8s.get_resource(rtb_ref)
+        assert rtb_cr["status"]["routeOwners"] == {destination_cidr_block: f"default/{resource_name}"}
+        assert get_route(ec2_client, route_table_id, destination_cidr_block) is not None
+        assert get_route(ec2_client, route_table_id, "192.168.0.0/24") is None
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        assert get_route(ec2_client, route_table_id, destination_cidr_block) is None
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_route_table(route_table_id)
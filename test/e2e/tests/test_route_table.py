@@ -83,6 +83,87 @@ def simple_route_table(request):
     except:
         pass
 
+@pytest.fixture
+def dedicated_vpc():
+    resource_name = random_suffix_name("main-rtb-vpc", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["VPC_NAME"] = resource_name
+    replacements["CIDR_BLOCK"] = "10.93.0.0/16"
+    replacements["ENABLE_DNS_SUPPORT"] = "True"
+    replacements["ENABLE_DNS_HOSTNAMES"] = "False"
+    replacements["ENABLE_NETWORK_ADDRESS_USAGE_METRICS"] = "False"
+    replacements["DISALLOW_DEFAULT_SECURITY_GROUP_RULE"] = "False"
+    replacements["TAG_KEY"] = "main-route-table-test"
+    replacements["TAG_VALUE"] = "vpc"
+
+    resource_data = load_ec2_resource(
+        "vpc",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, "vpcs",
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+@pytest.fixture
+def dedicated_internet_gateway(dedicated_vpc):
+    (_, vpc_cr) = dedicated_vpc
+    resource_name = random_suffix_name("edge-rtb-igw", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["INTERNET_GATEWAY_NAME"] = resource_name
+    replacements["VPC_ID"] = vpc_cr["status"]["vpcID"]
+
+    resource_data = load_ec2_resource(
+        "internet_gateway_vpc_attachment",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, "internetgateways",
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+def get_main_route_table_id(ec2_client, vpc_id: str) -> str:
+    aws_res = ec2_client.describe_route_tables(
+        Filters=[
+            {"Name": "vpc-id", "Values": [vpc_id]},
+            {"Name": "association.main", "Values": ["true"]},
+        ]
+    )
+    assert len(aws_res["RouteTables"]) == 1
+    return aws_res["RouteTables"][0]["RouteTableId"]
+
 @service_marker
 @pytest.mark.canary
 class TestRouteTable:
@@ -255,4 +336,103 @@ class TestRouteTable:
         time.sleep(DELETE_WAIT_AFTER_SECONDS)
 
         # Check Route Table no longer exists in AWS
-        ec2_validator.assert_route_table(resource_id, exists=False)
\ No newline at end of file
+        ec2_validator.assert_route_table(resource_id, exists=False)
+
+    def test_main_route_table(self, ec2_client, dedicated_vpc):
+        (_, vpc_cr) = dedicated_vpc
+        vpc_id = vpc_cr["status"]["vpcID"]
+        original_main_id = get_main_route_table_id(ec2_client, vpc_id)
+
+        resource_name = random_suffix_name("main-route-table", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["ROUTE_TABLE_NAME"] = resource_name
+        replacements["VPC_ID"] = vpc_id
+
+        resource_data = load_ec2_resource(
+            "route_table_main",
+            additional_replacements=replacements,
+        )
+        logging.debug(resource_data)
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resource_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+        assert cr is not None
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        # The route table replaced the original main route table of the VPC
+        cr = k8s.get_resource(ref)
+        resource_id = cr["status"]["routeTableID"]
+        assert get_main_route_table_id(ec2_client, vpc_id) == resource_id
+        assert cr["status"]["originalMainRouteTableID"] == original_main_id
+
+        # Delete k8s resource
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        # The original main route table is restored
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_route_table(resource_id, exists=False)
+        assert get_main_route_table_id(ec2_client, vpc_id) == original_main_id
+
+    def test_gateway_association(self, ec2_client, dedicated_vpc, dedicated_internet_gateway):
+        (_, vpc_cr) = dedicated_vpc
+        (igw_ref, igw_cr) = dedicated_internet_gateway
+        vpc_id = vpc_cr["status"]["vpcID"]
+        igw_id = igw_cr["status"]["internetGatewayID"]
+
+        resource_name = random_suffix_name("edge-route-table", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["ROUTE_TABLE_NAME"] = resource_name
+        replacements["VPC_ID"] = vpc_id
+        replacements["IGW_REF_NAME"] = igw_ref.name
+
+        resource_data = load_ec2_resource(
+            "route_table_gateway",
+            additional_replacements=replacements,
+        )
+        logging.debug(resource_data)
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resource_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+        assert cr is not None
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        # The route table is associated with the internet gateway and the
+        # state of the association is reported in the status
+        cr = k8s.get_resource(ref)
+        resource_id = cr["status"]["routeTableID"]
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_route_table_association(resource_id, igw_id, "associated")
+        gateway_assocs = [a for a in cr["status"]["associations"] if a.get("gatewayID") == igw_id]
+        assert len(gateway_assocs) == 1
+        assert gateway_assocs[0]["associationState"]["state"] == "associated"
+
+        # Unsetting the gateway leaves the association alone, as it may be
+        # managed through the RouteTables of the InternetGateway
+        k8s.patch_custom_resource(ref, {"spec": {"gatewayRef": None}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        ec2_validator.assert_route_table_association(resource_id, igw_id, "associated")
+
+        # The association is removed before deletion
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_route_table(resource_id, exists=False)
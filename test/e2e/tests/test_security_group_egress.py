@@ -0,0 +1,150 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+
+"""Integration tests for the SecurityGroupEgress API.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+
+RESOURCE_PLURAL = "securitygroupegresses"
+SECURITY_GROUP_PLURAL = "securitygroups"
+
+CREATE_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+
+RULE_OWNER_TAG_KEY = "ec2.services.k8s.aws/rule-owner"
+
+@pytest.fixture
+def security_group():
+    resource_name = random_suffix_name("sg-egress-owner", 24)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["SECURITY_GROUP_NAME"] = resource_name
+    replacements["SECURITY_GROUP_DESCRIPTION"] = "TestSecurityGroupEgress"
+    replacements["VPC_ID"] = get_bootstrap_resources().SharedTestVPC.vpc_id
+    replacements["IP_PROTOCOL"] = "tcp"
+    replacements["FROM_PORT"] = "80"
+    replacements["TO_PORT"] = "80"
+    replacements["CIDR_IP"] = "10.0.0.0/16"
+    replacements["DESCRIPTION_INGRESS"] = "platform rule"
+
+    resource_data = load_ec2_resource(
+        "security_group_rule",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, SECURITY_GROUP_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+def get_rule(ec2_client, rule_id: str):
+    try:
+        aws_res = ec2_client.describe_security_group_rules(SecurityGroupRuleIds=[rule_id])
+    except ec2_client.exceptions.ClientError:
+        return None
+    rules = aws_res["SecurityGroupRules"]
+    return rules[0] if rules else None
+
+@service_marker
+@pytest.mark.canary
+class TestSecurityGroupEgress:
+    def test_create_update_delete(self, ec2_client, security_group):
+        (sg_ref, sg_cr) = security_group
+        security_group_id = sg_cr["status"]["id"]
+
+        resource_name = random_suffix_name("sg-egress-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["SECURITY_GROUP_EGRESS_NAME"] = resource_name
+        replacements["SECURITY_GROUP_REF_NAME"] = sg_ref.name
+        replacements["FROM_PORT"] = "443"
+        replacements["TO_PORT"] = "443"
+        replacements["CIDR_IP"] = "0.0.0.0/0"
+        replacements["DESCRIPTION"] = "load balancer"
+
+        resource_data = load_ec2_resource(
+            "security_group_egress",
+            additional_replacements=replacements,
+        )
+        logging.debug(resource_data)
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resource_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+        assert cr is not None
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        cr = k8s.get_resource(ref)
+        assert cr["spec"]["groupID"] == security_group_id
+        rule_id = cr["status"]["securityGroupRuleID"]
+        rule = get_rule(ec2_client, rule_id)
+        assert rule is not None
+        assert rule["IsEgress"] is True
+        assert rule["FromPort"] == 443
+        assert {"Key": RULE_OWNER_TAG_KEY, "Value": f"default/{resource_name}"} in rule["Tags"]
+        assert "tags" not in cr["spec"] or all(
+            t["key"] != RULE_OWNER_TAG_KEY for t in cr["spec"]["tags"]
+        )
+
+        # The SecurityGroup, which declares no egress rules, neither lists
+        # nor revokes the rule
+        k8s.patch_custom_resource(sg_ref, {"spec": {"ingressRules": []}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(sg_ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        sg_cr = k8s.get_resource(sg_ref)
+        assert not sg_cr["spec"].get("egressRules")
+        assert get_rule(ec2_client, rule_id) is not None
+
+        # The rule is modified in place
+        k8s.patch_custom_resource(ref, {"spec": {"fromPort": 8443, "toPort": 8443}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        rule = get_rule(ec2_client, rule_id)
+        assert rule["FromPort"] == 8443
+        assert rule["ToPort"] == 8443
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        assert get_rule(ec2_client, rule_id) is None
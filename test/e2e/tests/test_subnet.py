@@ -14,6 +14,7 @@
 """Integration tests for the Subnet API.
 """
 
+import ipaddress
 import pytest
 import time
 import logging
@@ -405,4 +406,124 @@ class TestSubnet:
         # An error occurred (InvalidParameterValue) when calling the CreateSubnet operation:
         # Value (InvalidCidrBlock) for parameter cidrBlock is invalid.
         # This is not a valid CIDR block.
-        assert expected_msg in terminal_condition['message']
\ No newline at end of file
+        assert expected_msg in terminal_condition['message']
+    def test_cidr_block_from_vpc(self, ec2_client):
+        vpc_name = random_suffix_name("subnet-carve-vpc", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VPC_NAME"] = vpc_name
+        replacements["CIDR_BLOCK"] = "10.30.0.0/16"
+        replacements["TAG_KEY"] = "initialtagkey"
+        replacements["TAG_VALUE"] = "initialtagvalue"
+
+        vpc_ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, "vpcs",
+            vpc_name, namespace="default",
+        )
+        k8s.create_custom_resource(vpc_ref, load_ec2_resource("vpc", additional_replacements=replacements))
+        assert k8s.wait_on_condition(vpc_ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        # Create several Subnets at once so they race for the same blocks
+        refs = []
+        for _ in range(3):
+            resource_name = random_suffix_name("subnet-carve", 24)
+            replacements = REPLACEMENT_VALUES.copy()
+            replacements["SUBNET_NAME"] = resource_name
+            replacements["VPC_REF_NAME"] = vpc_name
+            replacements["IPV4_NETMASK_LENGTH"] = "24"
+
+            ref = k8s.CustomResourceReference(
+                CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+                resource_name, namespace="default",
+            )
+            k8s.create_custom_resource(
+                ref,
+                load_ec2_resource("subnet_netmask_length", additional_replacements=replacements),
+            )
+            refs.append(ref)
+
+        cidr_blocks = []
+        ec2_validator = EC2Validator(ec2_client)
+        for ref in refs:
+            assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+            cr = k8s.get_resource(ref)
+            subnet = ec2_validator.get_subnet(cr["status"]["subnetID"])
+            # The picked block is pinned in the spec
+            assert cr["spec"]["cidrBlock"] == subnet["CidrBlock"]
+            cidr_blocks.append(subnet["CidrBlock"])
+
+        assert sorted(cidr_blocks) == ["10.30.0.0/24", "10.30.1.0/24", "10.30.2.0/24"]
+
+        for ref in refs:
+            _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+            assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        _, deleted = k8s.delete_custom_resource(vpc_ref, 3, 10)
+        assert deleted is True
+
+    def test_ipv6_cidr_block_association(self, ec2_client):
+        vpc_name = random_suffix_name("subnet-ipv6-vpc", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VPC_NAME"] = vpc_name
+        replacements["CIDR_BLOCK"] = "10.31.0.0/16"
+
+        vpc_ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, "vpcs",
+            vpc_name, namespace="default",
+        )
+        k8s.create_custom_resource(vpc_ref, load_ec2_resource("vpc_ipv6", additional_replacements=replacements))
+        assert k8s.wait_on_condition(vpc_ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        ec2_validator = EC2Validator(ec2_client)
+        vpc = ec2_validator.get_vpc(k8s.get_resource(vpc_ref)["status"]["vpcID"])
+        vpc_ipv6_cidr = vpc["Ipv6CidrBlockAssociationSet"][0]["Ipv6CidrBlock"]
+        subnet_ipv6_cidr = str(next(ipaddress.ip_network(vpc_ipv6_cidr).subnets(new_prefix=64)))
+
+        # Start with an IPv4 only subnet
+        resource_name = random_suffix_name("subnet-ipv6", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["SUBNET_NAME"] = resource_name
+        replacements["CIDR_BLOCK"] = "10.31.0.0/24"
+        replacements["VPC_REF_NAME"] = vpc_name
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resource_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, load_ec2_resource("subnet_vpc_ref", additional_replacements=replacements))
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        subnet_id = k8s.get_resource(ref)["status"]["subnetID"]
+
+        def associated_ipv6_cidr_blocks():
+            subnet = ec2_validator.get_subnet(subnet_id)
+            return [
+                a["Ipv6CidrBlock"] for a in subnet.get("Ipv6CidrBlockAssociationSet", [])
+                if a["Ipv6CidrBlockState"]["State"] == "associated"
+            ]
+
+        assert associated_ipv6_cidr_blocks() == []
+
+        # Migrate the subnet to dual stack in place
+        k8s.patch_custom_resource(ref, {"spec": {"ipv6CIDRBlock": subnet_ipv6_cidr}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        assert associated_ipv6_cidr_blocks() == [subnet_ipv6_cidr]
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["subnetID"] == subnet_id
+        states = [a["ipv6CIDRBlockState"]["state"] for a in cr["status"]["ipv6CIDRBlockAssociationSet"]]
+        assert "associated" in states
+
+        # And back to IPv4 only
+        k8s.patch_custom_resource(ref, {"spec": {"ipv6CIDRBlock": None}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        assert associated_ipv6_cidr_blocks() == []
+
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        _, deleted = k8s.delete_custom_resource(vpc_ref, 3, 10)
+        assert deleted is True
@@ -201,3 +201,56 @@ class TestTGW:
 
         # Check TransitGateway no longer exists in AWS
         ec2_validator.assert_transit_gateway(resource_id, exists=False)
+
+    def test_update_options(self, ec2_client, simple_transit_gateway):
+        (ref, cr) = simple_transit_gateway
+        resource_id = cr["status"]["transitGatewayID"]
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_transit_gateway(resource_id)
+
+        # Patch the TransitGateway, updating options and adding a CIDR block
+        updates = {
+            "spec": {
+                "description": "updated description",
+                "options": {
+                    "dnsSupport": "disable",
+                    "vpnECMPSupport": "disable",
+                    "transitGatewayCIDRBlocks": ["10.99.0.0/24"],
+                },
+            },
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        transit_gateway = ec2_validator.get_transit_gateway(resource_id)
+        assert transit_gateway["Description"] == "updated description"
+        assert transit_gateway["Options"]["DnsSupport"] == "disable"
+        assert transit_gateway["Options"]["VpnEcmpSupport"] == "disable"
+        assert transit_gateway["Options"]["TransitGatewayCidrBlocks"] == ["10.99.0.0/24"]
+
+        # Remove the CIDR block
+        updates = {
+            "spec": {"options": {"transitGatewayCIDRBlocks": None}},
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        transit_gateway = ec2_validator.get_transit_gateway(resource_id)
+        assert not transit_gateway["Options"].get("TransitGatewayCidrBlocks")
+
+        # MulticastSupport cannot be modified in place
+        updates = {
+            "spec": {"options": {"multicastSupport": "enable"}},
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+
+        # Delete k8s resource
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
@@ -0,0 +1,186 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the TransitGatewayPeeringAttachment API.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest import tags
+from acktest.aws.identity import get_account_id, get_region
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+
+RESOURCE_PLURAL = "transitgatewaypeeringattachments"
+TGW_RESOURCE_PLURAL = "transitgateways"
+
+## The peer TGW has to leave its "pending" state before it can be peered.
+CREATE_WAIT_AFTER_SECONDS = 90
+MODIFY_WAIT_AFTER_SECONDS = 30
+DELETE_WAIT_AFTER_SECONDS = 60
+WAIT_PERIOD = 30
+
+
+@pytest.fixture(scope="module")
+def peer_transit_gateway(ec2_client):
+    resource_name = random_suffix_name("tgw-peer-test", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["TGW_NAME"] = resource_name
+    replacements["TAG_KEY"] = "peer"
+    replacements["TAG_VALUE"] = resource_name
+
+    resource_data = load_ec2_resource(
+        "transitgateway",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, TGW_RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+    cr = k8s.get_resource(ref)
+    ec2_validator = EC2Validator(ec2_client)
+    assert ec2_validator.wait_transit_gateway_state(tgw_id=cr["status"]["transitGatewayID"], state='available')
+
+    yield (ref, cr)
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture
+def simple_tgw_peering_attachment(request, ec2_client, peer_transit_gateway):
+    resource_name = random_suffix_name("tgw-peering-test", 24)
+    test_tgw = get_bootstrap_resources().TestTransitGateway
+    (peer_ref, _) = peer_transit_gateway
+
+    ec2_validator = EC2Validator(ec2_client)
+    assert ec2_validator.wait_transit_gateway_state(tgw_id=test_tgw.transit_gateway_id, state='available')
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["TGWPA_NAME"] = resource_name
+    replacements["TGW_ID"] = test_tgw.transit_gateway_id
+    replacements["PEER_TGW_REF_NAME"] = peer_ref.name
+    replacements["PEER_ACCOUNT_ID"] = str(get_account_id())
+    replacements["PEER_REGION"] = get_region()
+
+    marker = request.node.get_closest_marker("resource_data")
+    if marker is not None:
+        data = marker.args[0]
+        if 'tag_key' in data:
+            replacements["TAG_KEY"] = data['tag_key']
+        if 'tag_value' in data:
+            replacements["TAG_VALUE"] = data['tag_value']
+
+    resource_data = load_ec2_resource(
+        "transitgateway_peering_attachment",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@service_marker
+@pytest.mark.canary
+class TestTransitGatewayPeeringAttachment:
+
+    @pytest.mark.resource_data({'tag_key': 'initialtagkey', 'tag_value': 'initialtagvalue'})
+    def test_crud(self, ec2_client, simple_tgw_peering_attachment):
+        (ref, cr) = simple_tgw_peering_attachment
+
+        # acceptRequest drives the attachment through pendingAcceptance to available
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        cr = k8s.get_resource(ref)
+        attachment_id = cr["status"]["id"]
+        assert cr["status"]["state"] == "available"
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_transit_gateway_peering_attachment(attachment_id)
+        attachment = ec2_validator.get_transit_gateway_peering_attachment(attachment_id)
+        assert attachment["State"] == "available"
+        assert attachment["AccepterTgwInfo"]["TransitGatewayId"] == cr["spec"]["peerTransitGatewayID"]
+
+        tags.assert_ack_system_tags(
+            tags=attachment["Tags"],
+        )
+        tags.assert_equal_without_ack_tags(
+            expected={"initialtagkey": "initialtagvalue"},
+            actual=attachment["Tags"],
+        )
+
+        # Update tags
+        updates = {
+            "spec": {"tags": [{"key": "updatedtagkey", "value": "updatedtagvalue"}]}
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        attachment = ec2_validator.get_transit_gateway_peering_attachment(attachment_id)
+        tags.assert_equal_without_ack_tags(
+            expected={"updatedtagkey": "updatedtagvalue"},
+            actual=attachment["Tags"],
+        )
+
+        # AcceptRequest cannot be reverted once the attachment is accepted
+        updates = {
+            "spec": {"acceptRequest": False}
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=WAIT_PERIOD)
+
+        # Delete k8s resource
+        _, deleted = k8s.delete_custom_resource(ref, 2, 5)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_transit_gateway_peering_attachment(attachment_id, exists=False)
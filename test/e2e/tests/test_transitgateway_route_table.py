@@ -0,0 +1,245 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the TransitGatewayRouteTable API.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest import tags
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+
+RESOURCE_PLURAL = "transitgatewayroutetables"
+ATTACHMENT_RESOURCE_PLURAL = "transitgatewayvpcattachments"
+
+CREATE_WAIT_AFTER_SECONDS = 30
+MODIFY_WAIT_AFTER_SECONDS = 30
+DELETE_WAIT_AFTER_SECONDS = 30
+WAIT_PERIOD = 30
+
+
+@pytest.fixture(scope="module")
+def tgw_attachment(ec2_client):
+    resource_name = random_suffix_name("tgw-rt-attach-test", 24)
+
+    test_vpc = get_bootstrap_resources().SharedTestVPC
+    test_tgw = get_bootstrap_resources().TestTransitGateway
+
+    ec2_validator = EC2Validator(ec2_client)
+    is_available = ec2_validator.wait_transit_gateway_state(tgw_id=test_tgw.transit_gateway_id, state='available')
+    assert is_available
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["TGWVA_NAME"] = resource_name
+    replacements["VPC_ID"] = test_vpc.vpc_id
+    replacements["TGW_ID"] = test_tgw.transit_gateway_id
+    replacements["SUBNET_ID"] = test_vpc.public_subnets.subnet_ids[0]
+    replacements["TAG_KEY"] = "attachment"
+    replacements["TAG_VALUE"] = resource_name
+
+    resource_data = load_ec2_resource(
+        "transitgateway_vpc_attachment",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, ATTACHMENT_RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture
+def simple_tgw_route_table(request, ec2_client):
+    resource_name = random_suffix_name("tgw-rt-test", 24)
+    test_tgw = get_bootstrap_resources().TestTransitGateway
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["TGWRT_NAME"] = resource_name
+    replacements["TGW_ID"] = test_tgw.transit_gateway_id
+
+    marker = request.node.get_closest_marker("resource_data")
+    if marker is not None:
+        data = marker.args[0]
+        if 'tag_key' in data:
+            replacements["TAG_KEY"] = data['tag_key']
+        if 'tag_value' in data:
+            replacements["TAG_VALUE"] = data['tag_value']
+
+    resource_data = load_ec2_resource(
+        "transitgateway_route_table",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@service_marker
+@pytest.mark.canary
+class TestTransitGatewayRouteTable:
+
+    @pytest.mark.resource_data({'tag_key': 'initialtagkey', 'tag_value': 'initialtagvalue'})
+    def test_crud(self, ec2_client, tgw_attachment, simple_tgw_route_table):
+        (ref, cr) = simple_tgw_route_table
+        (attachment_ref, attachment_cr) = tgw_attachment
+        attachment_id = attachment_cr["status"]["id"]
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        cr = k8s.get_resource(ref)
+        route_table_id = cr["status"]["transitGatewayRouteTableID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        route_table = ec2_validator.get_transit_gateway_route_table(route_table_id)
+        assert route_table is not None
+        tags.assert_ack_system_tags(
+            tags=route_table["Tags"],
+        )
+        tags.assert_equal_without_ack_tags(
+            expected={"initialtagkey": "initialtagvalue"},
+            actual=route_table["Tags"],
+        )
+        assert len(ec2_validator.get_transit_gateway_route_table_propagations(route_table_id)) == 0
+
+        # Enable propagation from the attachment through a reference
+        updates = {
+            "spec": {
+                "propagationRefs": [
+                    {"from": {"name": attachment_ref.name}},
+                ],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        propagations = ec2_validator.get_transit_gateway_route_table_propagations(route_table_id)
+        assert len(propagations) == 1
+        assert propagations[0]["TransitGatewayAttachmentId"] == attachment_id
+
+        # Disable the propagation again
+        updates = {
+            "spec": {
+                "propagationRefs": None,
+                "propagations": None,
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        assert len(ec2_validator.get_transit_gateway_route_table_propagations(route_table_id)) == 0
+
+        # Delete k8s resource
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_transit_gateway_route_table(route_table_id, exists=False)
+
+    @pytest.mark.resource_data({'tag_key': 'routes', 'tag_value': 'routes'})
+    def test_routes(self, ec2_client, tgw_attachment, simple_tgw_route_table):
+        (ref, cr) = simple_tgw_route_table
+        (attachment_ref, attachment_cr) = tgw_attachment
+        attachment_id = attachment_cr["status"]["id"]
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        cr = k8s.get_resource(ref)
+        route_table_id = cr["status"]["transitGatewayRouteTableID"]
+
+        # Add a static route to the attachment and a blackhole route
+        updates = {
+            "spec": {
+                "routes": [
+                    {
+                        "destinationCIDRBlock": "10.200.0.0/16",
+                        "transitGatewayAttachmentRef": {"from": {"name": attachment_ref.name}},
+                    },
+                    {
+                        "destinationCIDRBlock": "10.201.0.0/16",
+                        "blackhole": True,
+                    },
+                ],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        ec2_validator = EC2Validator(ec2_client)
+        routes = {r["DestinationCidrBlock"]: r for r in ec2_validator.get_transit_gateway_static_routes(route_table_id)}
+        assert routes["10.200.0.0/16"]["State"] == "active"
+        assert routes["10.200.0.0/16"]["TransitGatewayAttachments"][0]["TransitGatewayAttachmentId"] == attachment_id
+        assert routes["10.201.0.0/16"]["State"] == "blackhole"
+
+        cr = k8s.get_resource(ref)
+        statuses = {r["destinationCIDRBlock"]: r for r in cr["status"]["routeStatuses"]}
+        assert statuses["10.200.0.0/16"]["state"] == "active"
+        assert statuses["10.201.0.0/16"]["state"] == "blackhole"
+
+        # Turn the attachment route into a blackhole and drop the other one
+        updates = {
+            "spec": {
+                "routes": [
+                    {
+                        "destinationCIDRBlock": "10.200.0.0/16",
+                        "blackhole": True,
+                    },
+                ],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        routes = {r["DestinationCidrBlock"]: r for r in ec2_validator.get_transit_gateway_static_routes(route_table_id)}
+        assert "10.201.0.0/16" not in routes
+        assert routes["10.200.0.0/16"]["State"] == "blackhole"
@@ -0,0 +1,119 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Volume API.
+"""
+
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.tests.helper import EC2Validator
+from e2e.tests.test_network_interface import create_resource, simple_instance
+
+RESOURCE_PLURAL = "volumes"
+
+MODIFY_WAIT_AFTER_SECONDS = 30
+DELETE_WAIT_AFTER_SECONDS = 10
+
+
+def get_availability_zone(ec2_client, subnet_id: str) -> str:
+    return EC2Validator(ec2_client).get_subnet(subnet_id)["AvailabilityZone"]
+
+
+@service_marker
+@pytest.mark.canary
+class TestVolume:
+    def test_crud(self, ec2_client):
+        resource_name = random_suffix_name("volume-ack-test", 24)
+        subnet_id = get_bootstrap_resources().SharedTestVPC.public_subnets.subnet_ids[0]
+
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VOLUME_NAME"] = resource_name
+        replacements["AVAILABILITY_ZONE"] = get_availability_zone(ec2_client, subnet_id)
+        replacements["TAG_KEY"] = "initialtagkey"
+        replacements["TAG_VALUE"] = "initialtagvalue"
+
+        ref, cr = create_resource(RESOURCE_PLURAL, resource_name, "volume", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        cr = k8s.get_resource(ref)
+        volume_id = cr["status"]["volumeID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_volume(volume_id)
+
+        volume = ec2_validator.get_volume(volume_id)
+        assert volume["Size"] == 8
+        assert volume["VolumeType"] == "gp3"
+
+        # Grow the volume and raise its throughput in place
+        updates = {
+            "spec": {
+                "size": 10,
+                "throughput": 250,
+            },
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        volume = ec2_validator.get_volume(volume_id)
+        assert volume["Size"] == 10
+        assert volume["Throughput"] == 250
+
+        cr = k8s.get_resource(ref)
+        modification = cr["status"]["modification"]
+        assert modification["targetSize"] == 10
+        assert modification["modificationState"] in ("optimizing", "completed")
+
+        _, deleted = k8s.delete_custom_resource(ref)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_volume(volume_id, exists=False)
+
+    def test_attachment(self, ec2_client, simple_instance):
+        (instance_ref, instance_cr) = simple_instance
+        instance_id = instance_cr["status"]["instanceID"]
+
+        resource_name = random_suffix_name("volume-ack-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VOLUME_NAME"] = resource_name
+        replacements["AVAILABILITY_ZONE"] = get_availability_zone(ec2_client, instance_cr["spec"]["subnetID"])
+        replacements["INSTANCE_NAME"] = instance_ref.name
+
+        ref, cr = create_resource(RESOURCE_PLURAL, resource_name, "volume_attachment", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        cr = k8s.get_resource(ref)
+        volume_id = cr["status"]["volumeID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        volume = ec2_validator.get_volume(volume_id)
+        assert volume["Attachments"][0]["InstanceId"] == instance_id
+        assert volume["Attachments"][0]["Device"] == "/dev/sdf"
+
+        # Deleting the volume detaches it from the instance first
+        _, deleted = k8s.delete_custom_resource(ref, 6, 10)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_volume(volume_id, exists=False)
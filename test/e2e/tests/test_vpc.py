@@ -445,6 +445,13 @@ class TestVpc:
         assert vpc['CidrBlockAssociationSet'][0]['CidrBlock'] == PRIMARY_CIDR_DEFAULT
         assert vpc['CidrBlockAssociationSet'][1]['CidrBlock'] == "10.2.0.0/16"
 
+        # The VPC is only synced once every CIDR block is associated
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+        cr = k8s.get_resource(ref)
+        assert cr["spec"]["cidrBlocks"] == [PRIMARY_CIDR_DEFAULT, "10.2.0.0/16"]
+        states = [assoc["cidrBlockState"]["state"] for assoc in cr["status"]["cidrBlockAssociationSet"]]
+        assert states == ["associated", "associated"]
+
         # Delete k8s resource
         _, deleted = k8s.delete_custom_resource(ref, 3, 10)
         assert deleted is True
@@ -454,6 +461,59 @@ class TestVpc:
         # Check VPC no longer exists in AWS
         ec2_validator.assert_vpc(resource_id, exists=False)
 
+    def test_ipv6_cidr_blocks(self, ec2_client, simple_vpc):
+        (ref, cr) = simple_vpc
+        resource_id = cr["status"]["vpcID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+
+        def active_ipv6_cidr_blocks():
+            vpc = ec2_validator.get_vpc(resource_id)
+            return [
+                a for a in vpc.get("Ipv6CidrBlockAssociationSet", [])
+                if a["Ipv6CidrBlockState"]["State"] in ("associating", "associated")
+            ]
+
+        assert active_ipv6_cidr_blocks() == []
+
+        # Associate an Amazon-provided IPv6 CIDR block
+        updates = {
+            "spec": {"ipv6CIDRBlocks": [{"amazonProvidedIPv6CIDRBlock": True}]}
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        blocks = active_ipv6_cidr_blocks()
+        assert len(blocks) == 1
+        assert blocks[0]["Ipv6Pool"] == "Amazon"
+        assert blocks[0]["Ipv6CidrBlockState"]["State"] == "associated"
+
+        cr = k8s.get_resource(ref)
+        states = {
+            a["ipv6CIDRBlock"]: a["ipv6CIDRBlockState"]["state"]
+            for a in cr["status"]["ipv6CIDRBlockAssociationSet"]
+        }
+        assert states[blocks[0]["Ipv6CidrBlock"]] == "associated"
+
+        # Disassociate it again
+        updates = {
+            "spec": {"ipv6CIDRBlocks": []}
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        assert active_ipv6_cidr_blocks() == []
+
+        # Delete k8s resource
+        _, deleted = k8s.delete_custom_resource(ref, 2, 5)
+        assert deleted is True
+
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_vpc(resource_id, exists=False)
+
     def test_vpc_updation_multiple_cidr(self,ec2_client):
         resource_name = random_suffix_name("vpc-ack-multicidr", 24)
         replacements = REPLACEMENT_VALUES.copy()
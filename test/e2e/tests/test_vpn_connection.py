@@ -0,0 +1,273 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+# 	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CustomerGateway, VPNGateway, VPNConnection and
+VPNConnectionRoute APIs.
+"""
+
+import base64
+import pytest
+import time
+import logging
+
+from acktest.resources import random_suffix_name
+from acktest.k8s import resource as k8s
+from kubernetes import client as k8s_client
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_ec2_resource
+from e2e.conftest import simple_vpc
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.tests.helper import EC2Validator
+
+CUSTOMER_GATEWAY_PLURAL = "customergateways"
+VPN_GATEWAY_PLURAL = "vpngateways"
+VPN_CONNECTION_PLURAL = "vpnconnections"
+VPN_CONNECTION_ROUTE_PLURAL = "vpnconnectionroutes"
+ROUTE_TABLE_PLURAL = "routetables"
+
+CREATE_WAIT_AFTER_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 30
+## VPN gateways and connections take several minutes to become available.
+WAIT_PERIOD = 60
+
+
+def create_resource(plural: str, resource_name: str, resource_file: str, replacements: dict):
+    resource_data = load_ec2_resource(
+        resource_file,
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, plural,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    time.sleep(CREATE_WAIT_AFTER_SECONDS)
+
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    return ref, cr
+
+
+@pytest.fixture
+def simple_customer_gateway():
+    resource_name = random_suffix_name("cgw-ack-test", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["CUSTOMER_GATEWAY_NAME"] = resource_name
+    replacements["BGP_ASN"] = "65000"
+    replacements["IP_ADDRESS"] = "198.51.100.10"
+    replacements["TAG_KEY"] = "initialtagkey"
+    replacements["TAG_VALUE"] = "initialtagvalue"
+
+    ref, cr = create_resource(CUSTOMER_GATEWAY_PLURAL, resource_name, "customer_gateway", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture
+def simple_vpn_gateway(simple_vpc):
+    (vpc_ref, _) = simple_vpc
+    resource_name = random_suffix_name("vgw-ack-test", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["VPN_GATEWAY_NAME"] = resource_name
+    replacements["VPC_REF_NAME"] = vpc_ref.name
+    replacements["TAG_KEY"] = "initialtagkey"
+    replacements["TAG_VALUE"] = "initialtagvalue"
+
+    ref, cr = create_resource(VPN_GATEWAY_PLURAL, resource_name, "vpn_gateway", replacements)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+    yield (ref, k8s.get_resource(ref))
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+        assert deleted
+    except:
+        pass
+
+
+@pytest.fixture
+def pre_shared_key_secret():
+    secret_name = random_suffix_name("vpn-psk", 24)
+    k8s.create_opaque_secret("default", secret_name, "placeholder", "unused")
+
+    yield secret_name
+
+    k8s.delete_secret("default", secret_name)
+
+
+@service_marker
+class TestCustomerGateway:
+    def test_create_update_delete(self, ec2_client, simple_customer_gateway):
+        (ref, cr) = simple_customer_gateway
+        resource_id = cr["status"]["customerGatewayID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_customer_gateway(resource_id)
+        cgw = ec2_validator.get_customer_gateway(resource_id)
+        assert cgw["BgpAsn"] == "65000"
+        assert cgw["IpAddress"] == "198.51.100.10"
+
+        updates = {
+            "spec": {"tags": [{"key": "updatedtagkey", "value": "updatedtagvalue"}]},
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        cgw = ec2_validator.get_customer_gateway(resource_id)
+        assert {"Key": "updatedtagkey", "Value": "updatedtagvalue"} in cgw["Tags"]
+        assert {"Key": "initialtagkey", "Value": "initialtagvalue"} not in cgw["Tags"]
+
+        _, deleted = k8s.delete_custom_resource(ref, 2, 5)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_customer_gateway(resource_id, exists=False)
+
+
+@service_marker
+class TestVPNGateway:
+    def test_vpc_attachment(self, ec2_client, simple_vpc, simple_vpn_gateway):
+        (_, vpc_cr) = simple_vpc
+        (ref, cr) = simple_vpn_gateway
+        vpc_id = vpc_cr["status"]["vpcID"]
+        resource_id = cr["status"]["vpnGatewayID"]
+
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_vpn_gateway(resource_id)
+
+        assert cr["spec"]["vpc"] == vpc_id
+        vgw = ec2_validator.get_vpn_gateway(resource_id)
+        attachments = [a for a in vgw["VpcAttachments"] if a["State"] in ("attaching", "attached")]
+        assert len(attachments) == 1
+        assert attachments[0]["VpcId"] == vpc_id
+
+        # Deleting the gateway detaches it from the VPC first
+        _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_vpn_gateway(resource_id, exists=False)
+
+    def test_route_propagation(self, ec2_client, simple_vpc, simple_vpn_gateway):
+        (_, vpc_cr) = simple_vpc
+        (vgw_ref, vgw_cr) = simple_vpn_gateway
+        vgw_id = vgw_cr["status"]["vpnGatewayID"]
+
+        resource_name = random_suffix_name("rt-vgw-ack-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["ROUTE_TABLE_NAME"] = resource_name
+        replacements["VPC_ID"] = vpc_cr["status"]["vpcID"]
+        replacements["VPN_GATEWAY_REF_NAME"] = vgw_ref.name
+
+        ref, _ = create_resource(ROUTE_TABLE_PLURAL, resource_name, "route_table_vgw_propagation", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        cr = k8s.get_resource(ref)
+        route_table_id = cr["status"]["routeTableID"]
+        assert cr["spec"]["propagatingVPNGateways"] == [vgw_id]
+
+        ec2_validator = EC2Validator(ec2_client)
+        route_table = ec2_validator.get_route_table(route_table_id)
+        assert [p["GatewayId"] for p in route_table["PropagatingVgws"]] == [vgw_id]
+
+        # Disable the route propagation
+        updates = {
+            "spec": {"propagatingVPNGatewayRefs": None, "propagatingVPNGateways": None},
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=5)
+
+        route_table = ec2_validator.get_route_table(route_table_id)
+        assert route_table["PropagatingVgws"] == []
+
+        _, deleted = k8s.delete_custom_resource(ref, 2, 5)
+        assert deleted is True
+
+
+@service_marker
+class TestVPNConnection:
+    def test_create_route_delete(
+        self, ec2_client, simple_customer_gateway, simple_vpn_gateway, pre_shared_key_secret,
+    ):
+        (cgw_ref, _) = simple_customer_gateway
+        (vgw_ref, _) = simple_vpn_gateway
+
+        resource_name = random_suffix_name("vpn-ack-test", 24)
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["VPN_CONNECTION_NAME"] = resource_name
+        replacements["CUSTOMER_GATEWAY_REF_NAME"] = cgw_ref.name
+        replacements["VPN_GATEWAY_REF_NAME"] = vgw_ref.name
+        replacements["SECRET_NAME"] = pre_shared_key_secret
+        replacements["TAG_KEY"] = "initialtagkey"
+        replacements["TAG_VALUE"] = "initialtagvalue"
+
+        ref, cr = create_resource(VPN_CONNECTION_PLURAL, resource_name, "vpn_connection", replacements)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=WAIT_PERIOD)
+
+        cr = k8s.get_resource(ref)
+        resource_id = cr["status"]["vpnConnectionID"]
+        ec2_validator = EC2Validator(ec2_client)
+        ec2_validator.assert_vpn_connection(resource_id)
+
+        # Tunnel options are reported in status, without the pre-shared keys
+        assert len(cr["status"]["tunnelOptions"]) == 2
+        for tunnel in cr["status"]["tunnelOptions"]:
+            assert "outsideIPAddress" in tunnel
+            assert "preSharedKey" not in tunnel
+
+        # The pre-shared keys are written to the referenced Secret
+        secret = k8s_client.CoreV1Api().read_namespaced_secret(pre_shared_key_secret, "default")
+        assert "psk-tunnel1" in secret.data
+        assert "psk-tunnel2" in secret.data
+        assert base64.b64decode(secret.data["placeholder"]).decode() == "unused"
+
+        # Add a static route to the VPN connection
+        route_name = random_suffix_name("vpn-route-ack-test", 24)
+        route_replacements = REPLACEMENT_VALUES.copy()
+        route_replacements["VPN_CONNECTION_ROUTE_NAME"] = route_name
+        route_replacements["VPN_CONNECTION_REF_NAME"] = resource_name
+        route_replacements["DESTINATION_CIDR_BLOCK"] = "192.168.10.0/24"
+
+        route_ref, _ = create_resource(
+            VPN_CONNECTION_ROUTE_PLURAL, route_name, "vpn_connection_route", route_replacements,
+        )
+        assert k8s.wait_on_condition(route_ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        routes = ec2_validator.get_vpn_connection_routes(resource_id)
+        assert [r["DestinationCidrBlock"] for r in routes] == ["192.168.10.0/24"]
+
+        _, deleted = k8s.delete_custom_resource(route_ref, 2, 5)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        assert ec2_validator.get_vpn_connection_routes(resource_id) == []
+
+        _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+        assert deleted is True
+        time.sleep(DELETE_WAIT_AFTER_SECONDS)
+
+        ec2_validator.assert_vpn_connection(resource_id, exists=False)